@@ -3,11 +3,16 @@ package api
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"os"
+	"sync"
+	"time"
 
 	"github.com/go-resty/resty/v2"
-	"github.com/google/uuid"
 	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/ovalfi/go-sdk/model"
 )
@@ -20,6 +25,8 @@ type RemoteCalls interface {
 	CreateCustomer(ctx context.Context, request model.CreateCustomerRequest) (model.Customer, error)
 	UpdateCustomer(ctx context.Context, request model.UpdateCustomerRequest) (model.Customer, error)
 	GetAllCustomers(ctx context.Context) (model.AllCustomersResponse, error)
+	ListCustomers(ctx context.Context, filter model.CustomerFilter, page *model.Page) (model.AllCustomersResponse, error)
+	GetCustomerByReference(ctx context.Context, reference string) (model.Customer, error)
 	GetCustomerByID(ctx context.Context, customerID string) (model.Customer, error)
 	GetCustomerBalance(ctx context.Context, customerID, yieldOfferingID string) (model.CustomerBalance, error)
 	GetCustomerBalances(ctx context.Context, customerID string) (model.CustomerBalances, error)
@@ -33,11 +40,13 @@ type RemoteCalls interface {
 	DeleteTransferBatch(ctx context.Context, batchDate, currency, reason string) error
 	InitiateTerminalTransfer(ctx context.Context, request model.InitiateTerminalTransferRequest) (model.TerminalTransfer, error)
 	GetTerminalTransfers(ctx context.Context, status, sourceCurrency, destinationCurrency string, dateBetween *model.DateBetween, page *model.Page) (model.AllTransfersResponse, error)
+	ListTerminalTransfers(ctx context.Context, filter model.TerminalTransferFilter, page *model.Page) (model.AllTransfersResponse, error)
 	GetTerminalTransferByID(ctx context.Context, transferID string) (model.TerminalTransfer, error)
 	GetSettlementByID(ctx context.Context, settlementID string) (model.Settlement, error)
 
 	// Transaction APIs
 	GetTransactions(ctx context.Context, customerID, yieldOfferingID, status, reference, batchDate string, amount *float64, dateBetween *model.DateBetween, page *model.Page) (model.AllTransactionsResponse, error)
+	ListTransactions(ctx context.Context, filter model.TransactionFilter, page *model.Page) (model.AllTransactionsResponse, error)
 	CancelTransaction(ctx context.Context, transactionID, transactionType, reason string) error
 	CancelBatchTransaction(ctx context.Context, batchDate, transactionType, currency, reason string) error
 	GetBalances(ctx context.Context) (map[string]float64, error)
@@ -58,7 +67,9 @@ type RemoteCalls interface {
 	GetPayoutByID(ctx context.Context, payoutID string) (model.PayoutResponse, error)
 	InitiateDirectBulkPayout(ctx context.Context, request model.InitiateBulkPayoutRequest) (model.PayoutDetails, error)
 	InitiatePayout(ctx context.Context, currency, payoutType, beneficiaryType, remarks string, customerID *string, document *os.File) (model.PayoutDetails, error)
+	InitiatePayoutUpload(ctx context.Context, currency, payoutType, beneficiaryType, remarks string, customerID *string, document model.Upload) (model.PayoutDetails, error)
 	GetAllPayouts(ctx context.Context, status, search string, dateBetween model.DateBetween, page model.Page) (model.AllPayoutsResponse, error)
+	ListPayouts(ctx context.Context, filter model.PayoutFilter, page *model.Page) (model.AllPayoutsResponse, error)
 	CancelPayout(ctx context.Context, request model.CancelPayoutRequest) error
 	UpdatePayoutAccount(ctx context.Context, payoutID string, request model.TransferBeneficiaryDetails) error
 	GetPayoutConfig(ctx context.Context, currency string) (model.BulkPayoutConfig, error)
@@ -67,6 +78,7 @@ type RemoteCalls interface {
 	// Currency Swap APIs
 	InitiateCurrencySwap(ctx context.Context, request model.InitiateCurrencySwapRequest) (model.CurrencySwap, error)
 	GetCurrencySwaps(ctx context.Context, status, from, to string, dateBetween *model.DateBetween, page *model.Page) (model.AllSwapsResponse, error)
+	ListCurrencySwaps(ctx context.Context, filter model.SwapFilter, page *model.Page) (model.AllSwapsResponse, error)
 	GetCurrencySwapByID(ctx context.Context, currencySwapID string) (model.CurrencySwap, error)
 
 	// Beneficiary APIs
@@ -93,6 +105,7 @@ type RemoteCalls interface {
 	GetLinkToAddPaymentCard(ctx context.Context, request model.GetLinkToAddCardReq) (string, error)
 	GetLinkToAuthorizeCustomer(ctx context.Context, request model.GetLinkToAddCardReq) (string, error)
 	GetCustomerPaymentCards(ctx context.Context, customerID string, status, search *string, dateBetween *model.DateBetween, page *model.Page) (model.AllPaymentCardsResponse, error)
+	ListCustomerPaymentCards(ctx context.Context, customerID string, filter model.PaymentCardFilter, page *model.Page) (model.AllPaymentCardsResponse, error)
 	GetCustomerPaymentCardByID(ctx context.Context, customerID, ID string) (model.PaymentCard, error)
 	DebitPaymentCard(ctx context.Context, request model.DebitCustomerPaymentCardRequest) (model.Deposit, error)
 	RefundCustomerDeposit(ctx context.Context, request model.RefundCustomerDepositRequest) error
@@ -101,6 +114,7 @@ type RemoteCalls interface {
 	// KYC APIs
 	GetKYCByCustomerID(ctx context.Context, customerID string) (model.KYCResponse, error)
 	SubmitCustomerKYCDocument(ctx context.Context, customerID string, frontDocument *os.File, backDocument *os.File, documentType string, country string) (model.KYCResponse, error)
+	SubmitCustomerKYCDocumentUpload(ctx context.Context, customerID string, frontDocument model.Upload, backDocument *model.Upload, documentType string, country string) (model.KYCResponse, error)
 	VerifyCustomerKYC(ctx context.Context, customerID, idNumber, kycType string) (interface{}, error)
 	GetVerifyBiometricsLink(ctx context.Context, customerID string) (string, error)
 	GetVerifyCustomerKYC(ctx context.Context, customerID string, country, hasExpiredID *string) (model.VerifyCustomerKYCResponse, error)
@@ -138,41 +152,98 @@ type RemoteCalls interface {
 	PayBill(ctx context.Context, request model.PayBillRequest) (model.BillPaymentTransaction, error)
 	GetBillPaymentTransaction(ctx context.Context, billPaymentID string) (model.BillPaymentTransaction, error)
 
-	// RunInSandboxMode this forces Call functionalities to run in sandbox mode for relevant logic/API consumption
+	// Iterators over every page of the list APIs
+	Customers(ctx context.Context, filter model.CustomerFilter, opts ListOptions) CustomerSeq
+	TerminalTransfers(ctx context.Context, filter model.TerminalTransferFilter, opts ListOptions) TerminalTransferSeq
+	Transactions(ctx context.Context, filter model.TransactionFilter, opts ListOptions) TransactionSeq
+	Payouts(ctx context.Context, filter model.PayoutFilter, opts ListOptions) PayoutSeq
+	CurrencySwaps(ctx context.Context, filter model.SwapFilter, opts ListOptions) CurrencySwapSeq
+	Beneficiaries(ctx context.Context, currency string, opts ListOptions) BeneficiarySeq
+	CustomerPaymentCards(ctx context.Context, customerID string, filter model.PaymentCardFilter, opts ListOptions) PaymentCardSeq
+	BillerProducts(ctx context.Context, category, biller, country string, billingType *string, opts ListOptions) BillerProductSeq
+
+	// Concurrent fetchers streaming every record of a date range
+	BulkTransactions(ctx context.Context, filter model.TransactionFilter, opts BulkOptions) <-chan TransactionItem
+	BulkTerminalTransfers(ctx context.Context, filter model.TerminalTransferFilter, opts BulkOptions) <-chan TerminalTransferItem
+	BulkPayouts(ctx context.Context, filter model.PayoutFilter, opts BulkOptions) <-chan PayoutItem
+
+	// RunInSandboxMode this forces Call functionalities to run in sandbox mode for relevant logic/API consumption.
+	// It enables sandbox-only operations such as MockDeposit and is ignored when the client points at production
 	RunInSandboxMode()
+	// SetRetryPolicy sets the retry policy used for every call made by the client
+	SetRetryPolicy(policy RetryPolicy)
+	// CircuitBreakerStates returns the state of the circuit breaker of every endpoint group, for use in health checks
+	CircuitBreakerStates() map[EndpointGroup]BreakerState
+	// InvalidateReferenceData drops the cached responses of the given methods, or of every method when none is given
+	InvalidateReferenceData(operations ...string)
 }
 
+// ErrSandboxOnly when a sandbox-only operation is called on a client that is not running in sandbox mode
+var ErrSandboxOnly = errors.New("operation is only available in sandbox mode")
+
 // Call object
 type Call struct {
-	client       *resty.Client
-	logger       zerolog.Logger
-	baseURL      string
-	apiSecret    string
-	bearerToken  string
-	sandboxMode  bool
-	idempotentID uuid.UUID
+	client      *resty.Client
+	logger      zerolog.Logger
+	baseURL     string
+	credentials CredentialsProvider
+	userAgent   string
+	sandboxMode bool
+	debug       bool
+	timeout     time.Duration
+	retryPolicy RetryPolicy
+	middlewares []Middleware
+	tracer      trace.Tracer
+	propagator  propagation.TextMapPropagator
+	metrics     MetricsRecorder
+	limiters    map[EndpointGroup]*limiter
+	breakers    sync.Map
+	cache       *responseCache
+
+	breakerSettings *CircuitBreakerSettings
+	maxUploadSize   int64
+	businessID      string
+	timeoutPolicy   TimeoutPolicy
+
+	payloadLogging PayloadLogging
 }
 
-// New initialises the object Call
-func New(z *zerolog.Logger, c *resty.Client, apiSecret, bearerToken, bURL string) RemoteCalls {
-	call := &Call{
-		client:       c,
-		logger:       z.With().Str("sdk", "ovalfi").Logger(),
-		baseURL:      bURL,
-		apiSecret:    apiSecret,
-		bearerToken:  bearerToken,
-		idempotentID: uuid.New(),
-	}
+// New initialises the object Call. Unlike NewClient, calls are neither retried nor bounded by a timeout policy unless
+// opted in through opts, e.g. WithRetries(DefaultRetryPolicy) or WithTimeoutPolicy(DefaultTimeoutPolicy)
+func New(z *zerolog.Logger, c *resty.Client, apiSecret, bearerToken, bURL string, opts ...Option) RemoteCalls {
+	call, _ := newCall(newOptions(append([]Option{
+		WithRetries(NoRetryPolicy),
+		WithTimeoutPolicy(TimeoutPolicy{}),
+		WithLogger(z),
+		WithRestyClient(c),
+		WithCredentials(apiSecret, bearerToken),
+		WithBaseURL(bURL),
+	}, opts...)...))
+	// sandbox mode stays opt-in through RunInSandboxMode, as it was before NewClient
+	call.sandboxMode = false
 	return RemoteCalls(call)
 }
 
-// ReloadIdempotentID this reissues a new idempotent ID
-func (c *Call) ReloadIdempotentID() {
-	c.idempotentID = uuid.New()
-}
+// ReloadIdempotentID does nothing, it is kept for compatibility
+//
+// Deprecated: every mutating call sends its own idempotency key, taken from WithIdempotencyKey, derived from the request
+// reference or generated for the call. Use WithIdempotencyKey to set the key of a specific call.
+func (c *Call) ReloadIdempotentID() {}
 
-// RunInSandboxMode this forces Call functionalities to run in sandbox mode for relevant logic/API consumption
+// RunInSandboxMode this forces Call functionalities to run in sandbox mode for relevant logic/API consumption.
+// It only affects this Call and is ignored when the Call points at the production environment
 func (c *Call) RunInSandboxMode() {
-	c.client.SetDebug(true)
+	if isProductionURL(c.baseURL) {
+		c.logger.Error().Str("endpoint", c.baseURL).Msg("cannot run in sandbox mode against production")
+		return
+	}
 	c.sandboxMode = true
 }
+
+// requireSandbox returns ErrSandboxOnly when the Call is not running in sandbox mode
+func (c *Call) requireSandbox(operation string) error {
+	if !c.sandboxMode {
+		return fmt.Errorf("%s: %w", operation, ErrSandboxOnly)
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ovalfi/go-sdk/model"
+)
+
+func TestRunInSandboxModeDoesNotTouchSharedClient(t *testing.T) {
+	logger := zerolog.Nop()
+	shared := resty.New()
+
+	first := New(&logger, shared, "secret", "token", "http://localhost/")
+	second := New(&logger, shared, "secret", "token", "http://localhost/")
+
+	first.RunInSandboxMode()
+
+	assert.False(t, shared.Debug)
+	assert.True(t, first.(*Call).sandboxMode)
+	assert.False(t, second.(*Call).debug)
+}
+
+func TestRunInSandboxModeIgnoredInProduction(t *testing.T) {
+	client, err := NewClient(WithCredentials("secret", "token"), WithEnvironment(Production))
+	assert.NoError(t, err)
+
+	client.RunInSandboxMode()
+	assert.False(t, client.(*Call).sandboxMode)
+}
+
+func TestMockDepositSandboxGuard(t *testing.T) {
+	var calls int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		assert.Equal(t, "/v1/payments/mock", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(`{"data":null}`))
+		assert.NoError(t, err)
+	}))
+	defer ts.Close()
+
+	call := newTestCall(ts.URL)
+
+	err := call.MockDeposit(context.Background(), model.MockCustomerDepositRequest{})
+	assert.ErrorIs(t, err, ErrSandboxOnly)
+	assert.Equal(t, 0, calls)
+
+	call.RunInSandboxMode()
+	err = call.MockDeposit(context.Background(), model.MockCustomerDepositRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestSandboxModeFromEnvironment(t *testing.T) {
+	sandbox, err := NewClient(WithCredentials("secret", "token"), WithEnvironment(Sandbox))
+	assert.NoError(t, err)
+	assert.True(t, sandbox.(*Call).sandboxMode)
+
+	production, err := NewClient(WithCredentials("secret", "token"), WithEnvironment(Production))
+	assert.NoError(t, err)
+	assert.False(t, production.(*Call).sandboxMode)
+
+	err = production.MockDeposit(context.Background(), model.MockCustomerDepositRequest{})
+	assert.ErrorIs(t, err, ErrSandboxOnly)
+}
+
+func TestSandboxModeIsOptIn(t *testing.T) {
+	logger := zerolog.Nop()
+	tests := map[string]struct {
+		client   func() (RemoteCalls, error)
+		expected bool
+	}{
+		"New with the sandbox url": {
+			client: func() (RemoteCalls, error) {
+				return New(&logger, resty.New(), "secret", "token", model.SandboxBaseURL), nil
+			},
+		},
+		"New with the production url without trailing slash": {
+			client: func() (RemoteCalls, error) {
+				return New(&logger, resty.New(), "secret", "token", "https://api.ovalfi-app.com/api"), nil
+			},
+		},
+		"NewClient defaults to the sandbox url": {
+			client: func() (RemoteCalls, error) {
+				return NewClient(WithCredentials("secret", "token"))
+			},
+			expected: true,
+		},
+		"NewClient with a proxy url": {
+			client: func() (RemoteCalls, error) {
+				return NewClient(WithCredentials("secret", "token"), WithBaseURL("https://ovalfi-proxy.internal/api/"))
+			},
+		},
+		"NewClient with an explicit sandbox environment and a proxy url": {
+			client: func() (RemoteCalls, error) {
+				return NewClient(WithCredentials("secret", "token"), WithEnvironment(Sandbox), WithBaseURL("http://localhost/"))
+			},
+			expected: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, err := tt.client()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, client.(*Call).sandboxMode)
+		})
+	}
+}
+
+func TestRunInSandboxModeIgnoredForProductionURL(t *testing.T) {
+	logger := zerolog.Nop()
+	client := New(&logger, resty.New(), "secret", "token", "https://api.ovalfi-app.com/api")
+
+	client.RunInSandboxMode()
+	assert.False(t, client.(*Call).sandboxMode)
+	assert.ErrorIs(t, client.MockDeposit(context.Background(), model.MockCustomerDepositRequest{}), ErrSandboxOnly)
+}
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"net/http"
 
-	"github.com/ovalfi/go-sdk/helpers"
 	"github.com/ovalfi/go-sdk/model"
 )
 
@@ -21,7 +20,7 @@ func (c *Call) ResolveBankAccount(ctx context.Context, request model.AccountReso
 		path     = fmt.Sprintf("%s/resolve-account", bankAPIVersion)
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodPost, nil, nil, nil, request, &response)
+	err = c.makeRequest(ctx, "ResolveBankAccount", path, http.MethodPost, nil, nil, nil, request, &response)
 
 	return response, err
 }
@@ -34,7 +33,7 @@ func (c *Call) GetBanks(ctx context.Context) ([]model.BankCode, error) {
 		path     = bankAPIVersion
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodGet, nil, nil, nil, nil, &response)
+	err = c.makeRequest(ctx, "GetBanks", path, http.MethodGet, nil, nil, nil, nil, &response)
 
 	return response, err
 }
@@ -56,7 +55,7 @@ func (c *Call) GetSupportedBanks(ctx context.Context, currency string, country,
 		params["payout_type"] = *payoutType
 	}
 
-	err = c.makeRequest(ctx, path, http.MethodGet, nil, params, nil, nil, &response)
+	err = c.makeRequest(ctx, "GetSupportedBanks", path, http.MethodGet, nil, params, nil, nil, &response)
 
 	return response, err
 }
@@ -75,7 +74,7 @@ func (c *Call) ValidatePhoneNumber(ctx context.Context, currency *string, countr
 	if currency != nil {
 		params["currency"] = *currency
 	}
-	err = c.makeRequest(ctx, path, http.MethodGet, nil, params, nil, nil, &response)
+	err = c.makeRequest(ctx, "ValidatePhoneNumber", path, http.MethodGet, nil, params, nil, nil, &response)
 
 	return response, err
 }
@@ -86,10 +85,10 @@ func (c *Call) GenerateBankAccount(ctx context.Context, request model.GenerateBa
 		err       error
 		response  model.BankAccount
 		path      = fmt.Sprintf("%s/account", bankAPIVersion)
-		signature = helpers.GetSignatureFromReferenceAndPubKey(request.Reference, c.apiSecret)
+		reference = request.Reference
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodPost, &signature, nil, nil, request, &response)
+	err = c.makeRequest(ctx, "GenerateBankAccount", path, http.MethodPost, &reference, nil, nil, request, &response)
 
 	return response, err
 }
@@ -106,7 +105,7 @@ func (c *Call) GetBankAccount(ctx context.Context, customerID, currency string)
 		path = fmt.Sprintf("%s/account", bankAPIVersion)
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodGet, nil, params, nil, nil, &response)
+	err = c.makeRequest(ctx, "GetBankAccount", path, http.MethodGet, nil, params, nil, nil, &response)
 
 	return response, err
 }
@@ -123,7 +122,7 @@ func (c *Call) GetTermsOfService(ctx context.Context, customerID, currency strin
 		path = fmt.Sprintf("%s/account/terms", bankAPIVersion)
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodGet, nil, params, nil, nil, &response)
+	err = c.makeRequest(ctx, "GetTermsOfService", path, http.MethodGet, nil, params, nil, nil, &response)
 
 	return response, err
 }
@@ -136,7 +135,7 @@ func (c *Call) ConfirmPayee(ctx context.Context, request model.ConfirmPayeeReque
 		path     = fmt.Sprintf("%s/confirm-payee", bankAPIVersion)
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodPost, nil, nil, nil, request, &response)
+	err = c.makeRequest(ctx, "ConfirmPayee", path, http.MethodPost, nil, nil, nil, request, &response)
 
 	return response, err
 }
@@ -148,7 +147,11 @@ func (c *Call) MockDeposit(ctx context.Context, request model.MockCustomerDeposi
 		path = "v1/payments/mock"
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodPost, nil, nil, nil, request, nil)
+	if err = c.requireSandbox("MockDeposit"); err != nil {
+		return err
+	}
+
+	err = c.makeRequest(ctx, "MockDeposit", path, http.MethodPost, nil, nil, nil, request, nil)
 
 	return err
 }
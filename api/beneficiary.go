@@ -19,7 +19,7 @@ func (c *Call) CreateBeneficiary(ctx context.Context, request model.CreateBenefi
 		path     = beneficiaryAPIVersion
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodPost, nil, nil, nil, request, &response)
+	err = c.makeRequest(ctx, "CreateBeneficiary", path, http.MethodPost, nil, nil, nil, request, &response)
 
 	return response, err
 }
@@ -40,7 +40,7 @@ func (c *Call) GetBeneficiaries(ctx context.Context, currency string, page *mode
 		helpers.FillParamsWithPage(params, *page)
 	}
 
-	err = c.makeRequest(ctx, path, http.MethodGet, nil, params, nil, nil, &response)
+	err = c.makeRequest(ctx, "GetBeneficiaries", path, http.MethodGet, nil, params, nil, nil, &response)
 
 	return response, err
 }
@@ -53,7 +53,7 @@ func (c *Call) GetBeneficiaryByID(ctx context.Context, beneficiaryID string) (mo
 		path     = fmt.Sprintf("%s/%s", beneficiaryAPIVersion, beneficiaryID)
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodGet, nil, nil, nil, nil, &response)
+	err = c.makeRequest(ctx, "GetBeneficiaryByID", path, http.MethodGet, nil, nil, nil, nil, &response)
 
 	return response, err
 }
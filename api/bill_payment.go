@@ -19,7 +19,7 @@ func (c *Call) GetBillerCategories(ctx context.Context, country string) ([]model
 		path     = fmt.Sprintf("%s/%s/categories", billPaymentAPIVersion, country)
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodGet, nil, nil, nil, nil, &response)
+	err = c.makeRequest(ctx, "GetBillerCategories", path, http.MethodGet, nil, nil, nil, nil, &response)
 
 	return response, err
 }
@@ -32,7 +32,7 @@ func (c *Call) GetBillers(ctx context.Context, category, country string) ([]mode
 		path     = fmt.Sprintf("%s/%s/categories/%s/billers", billPaymentAPIVersion, country, category)
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodGet, nil, nil, nil, nil, &response)
+	err = c.makeRequest(ctx, "GetBillers", path, http.MethodGet, nil, nil, nil, nil, &response)
 
 	return response, err
 }
@@ -53,7 +53,7 @@ func (c *Call) GetBillerProducts(ctx context.Context, category, biller, country
 		helpers.FillParamsWithPage(params, *page)
 	}
 
-	err = c.makeRequest(ctx, path, http.MethodGet, nil, params, nil, nil, &response)
+	err = c.makeRequest(ctx, "GetBillerProducts", path, http.MethodGet, nil, params, nil, nil, &response)
 
 	return response, err
 }
@@ -67,7 +67,7 @@ func (c *Call) ValidateBillerCustomer(ctx context.Context, request model.Validat
 		path     = fmt.Sprintf("%s/validate-customer", billPaymentAPIVersion)
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodPost, nil, nil, nil, request, &response)
+	err = c.makeRequest(ctx, "ValidateBillerCustomer", path, http.MethodPost, nil, nil, nil, request, &response)
 
 	return response, err
 }
@@ -80,7 +80,7 @@ func (c *Call) PayBill(ctx context.Context, request model.PayBillRequest) (model
 		path     = fmt.Sprintf("%s/pay", billPaymentAPIVersion)
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodPost, nil, nil, nil, request, &response)
+	err = c.makeRequest(ctx, "PayBill", path, http.MethodPost, nil, nil, nil, request, &response)
 
 	return response, err
 }
@@ -93,7 +93,7 @@ func (c *Call) GetBillPaymentTransaction(ctx context.Context, billPaymentID stri
 		path     = fmt.Sprintf("%s/payments/%s", billPaymentAPIVersion, billPaymentID)
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodGet, nil, nil, nil, nil, &response)
+	err = c.makeRequest(ctx, "GetBillPaymentTransaction", path, http.MethodGet, nil, nil, nil, nil, &response)
 
 	return response, err
 }
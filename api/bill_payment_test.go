@@ -20,7 +20,7 @@ func newTestCall(url string) *Call {
 		baseURL:     url + "/",
 		client:      resty.New(),
 		logger:      zerolog.Nop(),
-		bearerToken: "test-token",
+		credentials: StaticCredentials("", "test-token"),
 	}
 }
 
@@ -34,7 +34,7 @@ func TestGetBillerCategories(t *testing.T) {
 		assert.Equal(t, http.MethodGet, r.Method)
 		assert.Equal(t, "/v1/bills/NG/categories", r.URL.Path)
 
-		body, err := json.Marshal(model.GenericResponse{Data: expected})
+		body, err := json.Marshal(model.GenericResponse{Data: rawJSON(t, expected)})
 		assert.NoError(t, err)
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
@@ -59,7 +59,7 @@ func TestGetBillers(t *testing.T) {
 		assert.Equal(t, http.MethodGet, r.Method)
 		assert.Equal(t, "/v1/bills/NG/categories/airtime/billers", r.URL.Path)
 
-		body, err := json.Marshal(model.GenericResponse{Data: expected})
+		body, err := json.Marshal(model.GenericResponse{Data: rawJSON(t, expected)})
 		assert.NoError(t, err)
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
@@ -89,7 +89,7 @@ func TestGetBillerProducts(t *testing.T) {
 		assert.Equal(t, "prepaid", r.URL.Query().Get("billing_type"))
 		assert.Equal(t, "1", r.URL.Query().Get("number"))
 
-		body, err := json.Marshal(model.GenericResponse{Data: expected})
+		body, err := json.Marshal(model.GenericResponse{Data: rawJSON(t, expected)})
 		assert.NoError(t, err)
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
@@ -119,7 +119,7 @@ func TestValidateBillerCustomer(t *testing.T) {
 		assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
 		assert.Equal(t, request, received)
 
-		body, err := json.Marshal(model.GenericResponse{Data: expected})
+		body, err := json.Marshal(model.GenericResponse{Data: rawJSON(t, expected)})
 		assert.NoError(t, err)
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
@@ -156,7 +156,7 @@ func TestPayBill(t *testing.T) {
 		assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
 		assert.Equal(t, request, received)
 
-		body, err := json.Marshal(model.GenericResponse{Data: expected})
+		body, err := json.Marshal(model.GenericResponse{Data: rawJSON(t, expected)})
 		assert.NoError(t, err)
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
@@ -187,7 +187,7 @@ func TestGetBillPaymentTransaction(t *testing.T) {
 		assert.Equal(t, http.MethodGet, r.Method)
 		assert.Equal(t, "/v1/bills/payments/bp-1", r.URL.Path)
 
-		body, err := json.Marshal(model.GenericResponse{Data: expected})
+		body, err := json.Marshal(model.GenericResponse{Data: rawJSON(t, expected)})
 		assert.NoError(t, err)
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
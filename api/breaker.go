@@ -0,0 +1,259 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/ovalfi/go-sdk/model"
+)
+
+// BreakerState is the state of a circuit breaker
+type BreakerState string
+
+const (
+	// BreakerClosed lets every request through
+	BreakerClosed BreakerState = "closed"
+	// BreakerOpen fails every request fast until the cool-down has elapsed
+	BreakerOpen BreakerState = "open"
+	// BreakerHalfOpen lets a few probe requests through to find out whether the API has recovered
+	BreakerHalfOpen BreakerState = "half-open"
+)
+
+// outcome is the result of a request as far as the circuit breaker is concerned
+type outcome int
+
+const (
+	outcomeSuccess outcome = iota
+	outcomeFailure
+	outcomeIgnored
+)
+
+// ErrCircuitOpen is matched by the errors returned while the circuit breaker of an endpoint group is open
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+type (
+	// CircuitBreakerSettings tunes the circuit breakers of the client, one breaker runs per endpoint group
+	CircuitBreakerSettings struct {
+		// FailureRatio is the ratio of failed requests in the window above which the breaker opens
+		FailureRatio float64
+		// MinRequests is the number of requests in the window below which the breaker never opens
+		MinRequests int
+		// Window is the duration after which the counts of a closed breaker are reset
+		Window time.Duration
+		// CoolDown is how long the breaker stays open before letting probe requests through
+		CoolDown time.Duration
+		// HalfOpenRequests is the number of successful probes required to close the breaker again
+		HalfOpenRequests int
+	}
+
+	// CircuitOpenError is returned, without contacting the API, while the breaker of an endpoint group is open
+	CircuitOpenError struct {
+		// Group is the endpoint group whose breaker is open
+		Group EndpointGroup
+		// RetryAfter is the remaining cool-down before probe requests are let through
+		RetryAfter time.Duration
+	}
+
+	// breaker is a circuit breaker guarding one endpoint group
+	breaker struct {
+		mu       sync.Mutex
+		settings CircuitBreakerSettings
+		now      func() time.Time
+
+		state       BreakerState
+		windowStart time.Time
+		openedAt    time.Time
+		requests    int
+		failures    int
+		probes      int
+		successes   int
+	}
+)
+
+// DefaultCircuitBreakerSettings opens the breaker when half of at least 10 requests fail within a minute, for 30 seconds
+var DefaultCircuitBreakerSettings = CircuitBreakerSettings{
+	FailureRatio:     0.5,
+	MinRequests:      10,
+	Window:           time.Minute,
+	CoolDown:         30 * time.Second,
+	HalfOpenRequests: 1,
+}
+
+// WithCircuitBreaker enables a circuit breaker per endpoint group. Only network failures, 429 and 5xx responses count as failures
+func WithCircuitBreaker(settings CircuitBreakerSettings) Option {
+	return func(o *options) {
+		o.circuitBreaker = &settings
+	}
+}
+
+// Error implements the error interface
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("%s: %s, retry in %s", e.Group, ErrCircuitOpen, e.RetryAfter.Round(time.Millisecond))
+}
+
+// Unwrap allows errors.Is(err, ErrCircuitOpen)
+func (e *CircuitOpenError) Unwrap() error {
+	return ErrCircuitOpen
+}
+
+// CircuitBreakerStates returns the state of the circuit breaker of every endpoint group that has sent requests,
+// for use in health checks. It is empty when no circuit breaker is configured
+func (c *Call) CircuitBreakerStates() map[EndpointGroup]BreakerState {
+	states := make(map[EndpointGroup]BreakerState)
+	c.breakers.Range(func(key, value interface{}) bool {
+		states[key.(EndpointGroup)] = value.(*breaker).currentState()
+		return true
+	})
+	return states
+}
+
+// breakerFor returns the breaker of the endpoint group, or nil when no circuit breaker is configured
+func (c *Call) breakerFor(group EndpointGroup) *breaker {
+	if c.breakerSettings == nil {
+		return nil
+	}
+	if b, ok := c.breakers.Load(group); ok {
+		return b.(*breaker)
+	}
+	b, _ := c.breakers.LoadOrStore(group, newBreaker(*c.breakerSettings))
+	return b.(*breaker)
+}
+
+func newBreaker(settings CircuitBreakerSettings) *breaker {
+	if settings.HalfOpenRequests < 1 {
+		settings.HalfOpenRequests = 1
+	}
+	return &breaker{
+		settings: settings,
+		now:      time.Now,
+		state:    BreakerClosed,
+	}
+}
+
+// currentState returns the state of the breaker, moving it to half-open when the cool-down has elapsed
+func (b *breaker) currentState() BreakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.advance()
+	return b.state
+}
+
+// allow reports whether a request may be sent, returning a CircuitOpenError when it may not
+func (b *breaker) allow(group EndpointGroup) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.advance()
+
+	switch b.state {
+	case BreakerOpen:
+		return &CircuitOpenError{Group: group, RetryAfter: b.openedAt.Add(b.settings.CoolDown).Sub(b.now())}
+	case BreakerHalfOpen:
+		if b.probes >= b.settings.HalfOpenRequests {
+			return &CircuitOpenError{Group: group}
+		}
+		b.probes++
+	}
+	return nil
+}
+
+// record reports the outcome of a request let through by allow
+func (b *breaker) record(result outcome) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.advance()
+
+	switch b.state {
+	case BreakerHalfOpen:
+		switch result {
+		case outcomeFailure:
+			b.open()
+		case outcomeSuccess:
+			b.successes++
+			if b.successes >= b.settings.HalfOpenRequests {
+				b.close()
+			}
+		default:
+			// the probe did not tell anything about the API, let another one through
+			b.probes--
+		}
+	case BreakerClosed:
+		if result == outcomeIgnored {
+			return
+		}
+		b.requests++
+		if result == outcomeFailure {
+			b.failures++
+		}
+		if b.requests >= b.settings.MinRequests &&
+			float64(b.failures)/float64(b.requests) >= b.settings.FailureRatio {
+			b.open()
+		}
+	}
+}
+
+// outcomeOf tells whether the error returned by a request says anything about the health of the API. Only the
+// cancellation or expiry of the caller's own context is ignored, the timeouts set by the SDK or the transport are failures
+func outcomeOf(ctx context.Context, err error) outcome {
+	switch {
+	case err == nil:
+		return outcomeSuccess
+	case errors.Is(err, ErrUploadTooLarge), errors.Is(err, context.Canceled):
+		return outcomeIgnored
+	case errors.Is(err, context.DeadlineExceeded) && callerContext(ctx).Err() != nil:
+		return outcomeIgnored
+	case errors.Is(err, context.DeadlineExceeded), isTimeout(err), isRetryableError(err):
+		return outcomeFailure
+	default:
+		// business errors such as validation failures mean the API is up
+		return outcomeSuccess
+	}
+}
+
+// callerContext returns the context the call was made with, before the SDK added its timeouts
+func callerContext(ctx context.Context) context.Context {
+	if caller, ok := ctx.Value(model.CallerContextKey).(context.Context); ok {
+		return caller
+	}
+	return ctx
+}
+
+// isTimeout reports whether the transport gave up on the request
+func isTimeout(err error) bool {
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// advance applies the transitions that only depend on time
+func (b *breaker) advance() {
+	now := b.now()
+	switch b.state {
+	case BreakerOpen:
+		if !now.Before(b.openedAt.Add(b.settings.CoolDown)) {
+			b.state = BreakerHalfOpen
+			b.probes = 0
+			b.successes = 0
+		}
+	case BreakerClosed:
+		if b.settings.Window > 0 && now.Sub(b.windowStart) >= b.settings.Window {
+			b.windowStart = now
+			b.requests = 0
+			b.failures = 0
+		}
+	}
+}
+
+func (b *breaker) open() {
+	b.state = BreakerOpen
+	b.openedAt = b.now()
+}
+
+func (b *breaker) close() {
+	b.state = BreakerClosed
+	b.windowStart = b.now()
+	b.requests = 0
+	b.failures = 0
+}
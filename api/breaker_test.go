@@ -0,0 +1,218 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ovalfi/go-sdk/model"
+)
+
+func TestCircuitBreakerOpensAndFailsFast(t *testing.T) {
+	var hits int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte(`{"error":{"id":"unavailable","details":"service unavailable"}}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(
+		WithCredentials("secret", "token"),
+		WithBaseURL(ts.URL+"/"),
+		WithRetries(NoRetryPolicy),
+		WithCircuitBreaker(CircuitBreakerSettings{FailureRatio: 0.5, MinRequests: 3, Window: time.Minute, CoolDown: time.Minute}),
+	)
+	require.NoError(t, err)
+
+	request := model.AccountResolveRequest{BankCode: "058", AccountNumber: "0123456789"}
+	for i := 0; i < 3; i++ {
+		_, err = client.ResolveBankAccount(context.Background(), request)
+		assert.False(t, errors.Is(err, ErrCircuitOpen))
+	}
+
+	_, err = client.ResolveBankAccount(context.Background(), request)
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+	assert.Equal(t, "circuit_open", classifyError(err))
+
+	var openErr *CircuitOpenError
+	require.True(t, errors.As(err, &openErr))
+	assert.Equal(t, GroupReferenceData, openErr.Group)
+	assert.True(t, openErr.RetryAfter > 0)
+
+	assert.Equal(t, int32(3), atomic.LoadInt32(&hits))
+	assert.Equal(t, map[EndpointGroup]BreakerState{GroupReferenceData: BreakerOpen}, client.CircuitBreakerStates())
+}
+
+func TestCircuitBreakerIgnoresBusinessErrors(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte(`{"error":{"id":"validation_error","details":"invalid account number"}}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(
+		WithCredentials("secret", "token"),
+		WithBaseURL(ts.URL+"/"),
+		WithCircuitBreaker(CircuitBreakerSettings{FailureRatio: 0.5, MinRequests: 2, Window: time.Minute, CoolDown: time.Minute}),
+	)
+	require.NoError(t, err)
+
+	for i := 0; i < 5; i++ {
+		_, err = client.ResolveBankAccount(context.Background(), model.AccountResolveRequest{BankCode: "058"})
+		assert.ErrorIs(t, err, model.ErrValidation)
+	}
+	assert.Equal(t, BreakerClosed, client.CircuitBreakerStates()[GroupReferenceData])
+}
+
+func TestCircuitBreakerHalfOpen(t *testing.T) {
+	now := time.Now()
+	b := newBreaker(CircuitBreakerSettings{FailureRatio: 0.5, MinRequests: 2, Window: time.Minute, CoolDown: 10 * time.Second, HalfOpenRequests: 2})
+	b.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		require.NoError(t, b.allow(GroupPayments))
+		b.record(outcomeFailure)
+	}
+	assert.Equal(t, BreakerOpen, b.currentState())
+	assert.ErrorIs(t, b.allow(GroupPayments), ErrCircuitOpen)
+
+	now = now.Add(10 * time.Second)
+	assert.Equal(t, BreakerHalfOpen, b.currentState())
+
+	// only HalfOpenRequests probes are let through at once
+	require.NoError(t, b.allow(GroupPayments))
+	require.NoError(t, b.allow(GroupPayments))
+	assert.ErrorIs(t, b.allow(GroupPayments), ErrCircuitOpen)
+
+	// a canceled probe frees its slot
+	b.record(outcomeIgnored)
+	require.NoError(t, b.allow(GroupPayments))
+
+	b.record(outcomeSuccess)
+	assert.Equal(t, BreakerHalfOpen, b.currentState())
+	b.record(outcomeSuccess)
+	assert.Equal(t, BreakerClosed, b.currentState())
+}
+
+func TestCircuitBreakerHalfOpenFailureReopens(t *testing.T) {
+	now := time.Now()
+	b := newBreaker(CircuitBreakerSettings{FailureRatio: 1, MinRequests: 1, CoolDown: time.Second})
+	b.now = func() time.Time { return now }
+
+	require.NoError(t, b.allow(GroupBills))
+	b.record(outcomeFailure)
+	assert.Equal(t, BreakerOpen, b.currentState())
+
+	now = now.Add(time.Second)
+	require.NoError(t, b.allow(GroupBills))
+	b.record(outcomeFailure)
+	assert.Equal(t, BreakerOpen, b.currentState())
+
+	now = now.Add(500 * time.Millisecond)
+	assert.ErrorIs(t, b.allow(GroupBills), ErrCircuitOpen)
+}
+
+func TestCircuitBreakerWindowReset(t *testing.T) {
+	now := time.Now()
+	b := newBreaker(CircuitBreakerSettings{FailureRatio: 0.5, MinRequests: 2, Window: time.Minute, CoolDown: time.Minute})
+	b.now = func() time.Time { return now }
+
+	b.record(outcomeFailure)
+	now = now.Add(time.Minute)
+	b.record(outcomeFailure)
+	assert.Equal(t, BreakerClosed, b.currentState())
+}
+
+func TestOutcomeOf(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, outcomeSuccess, outcomeOf(ctx, nil))
+	assert.Equal(t, outcomeIgnored, outcomeOf(ctx, context.Canceled))
+	assert.Equal(t, outcomeFailure, outcomeOf(ctx, model.ErrNetworkError))
+	assert.Equal(t, outcomeFailure, outcomeOf(ctx, &model.APIError{StatusCode: http.StatusTooManyRequests}))
+	assert.Equal(t, outcomeSuccess, outcomeOf(ctx, &model.APIError{StatusCode: http.StatusNotFound}))
+
+	// a deadline counts against the API unless it is the deadline of the caller
+	assert.Equal(t, outcomeFailure, outcomeOf(ctx, context.DeadlineExceeded))
+	assert.Equal(t, outcomeFailure, outcomeOf(ctx, &net.OpError{Op: "read", Err: os.ErrDeadlineExceeded}))
+	expired, cancel := context.WithDeadline(ctx, time.Now().Add(-time.Second))
+	defer cancel()
+	assert.Equal(t, outcomeIgnored, outcomeOf(context.WithValue(ctx, model.CallerContextKey, expired), context.DeadlineExceeded))
+}
+
+func TestCircuitBreakerDisabled(t *testing.T) {
+	c := newTestCall("http://localhost")
+	assert.Nil(t, c.breakerFor(GroupPayments))
+	assert.Empty(t, c.CircuitBreakerStates())
+}
+
+func TestCircuitBreakerOpensOnTimeouts(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"USD":10}}`))
+	}))
+	defer ts.Close()
+
+	tests := map[string]Option{
+		"timeout policy": WithTimeoutPolicy(TimeoutPolicy{Default: 10 * time.Millisecond}),
+		"client timeout": WithTimeout(10 * time.Millisecond),
+	}
+	for name, timeout := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, err := NewClient(
+				WithCredentials("secret", "token"),
+				WithBaseURL(ts.URL+"/"),
+				WithRetries(NoRetryPolicy),
+				WithCircuitBreaker(CircuitBreakerSettings{FailureRatio: 0.5, MinRequests: 2, Window: time.Minute, CoolDown: time.Minute}),
+				timeout,
+			)
+			require.NoError(t, err)
+
+			for i := 0; i < 2; i++ {
+				_, err = client.GetBalances(context.Background())
+				assert.ErrorIs(t, err, context.DeadlineExceeded)
+			}
+
+			_, err = client.GetBalances(context.Background())
+			assert.ErrorIs(t, err, ErrCircuitOpen)
+			assert.Equal(t, map[EndpointGroup]BreakerState{GroupPayments: BreakerOpen}, client.CircuitBreakerStates())
+		})
+	}
+}
+
+func TestCircuitBreakerIgnoresCallerDeadline(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"USD":10}}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(
+		WithCredentials("secret", "token"),
+		WithBaseURL(ts.URL+"/"),
+		WithRetries(NoRetryPolicy),
+		WithCircuitBreaker(CircuitBreakerSettings{FailureRatio: 0.5, MinRequests: 2, Window: time.Minute, CoolDown: time.Minute}),
+	)
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		_, err = client.GetBalances(ctx)
+		cancel()
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	}
+	assert.Equal(t, map[EndpointGroup]BreakerState{GroupPayments: BreakerClosed}, client.CircuitBreakerStates())
+}
@@ -0,0 +1,366 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ovalfi/go-sdk/helpers"
+	"github.com/ovalfi/go-sdk/model"
+)
+
+const (
+	defaultBulkWindow      = 24 * time.Hour
+	defaultBulkConcurrency = 4
+	defaultBulkPageSize    = 100
+)
+
+// The items streamed by the bulk fetchers are BulkItem aliases, named so that mockgen can read RemoteCalls
+type (
+	// TransactionItem is a transaction streamed by BulkTransactions
+	TransactionItem = BulkItem[*model.Transaction]
+	// TerminalTransferItem is a terminal transfer streamed by BulkTerminalTransfers
+	TerminalTransferItem = BulkItem[model.TerminalTransfer]
+	// PayoutItem is a payout streamed by BulkPayouts
+	PayoutItem = BulkItem[model.PayoutDetails]
+)
+
+type (
+	// BulkItem is a record streamed by a bulk fetcher along with the window it was fetched in.
+	// An item carrying an error is the last one sent before the channel is closed
+	BulkItem[T any] struct {
+		Item   T
+		Window model.DateRange
+		Err    error
+	}
+
+	// BulkOptions tunes how a bulk fetcher splits its range and fetches the windows
+	BulkOptions struct {
+		// Range is the range fetched, both bounds are required
+		Range model.DateRange
+		// Window is the length of the windows the range is split into. Defaults to a day
+		Window time.Duration
+		// Concurrency is the number of windows fetched at the same time, each one page after the other. Defaults to 4
+		Concurrency int
+		// PageSize is the size of the pages fetched. Defaults to 100
+		PageSize int
+		// Checkpoint skips the windows completed by a previous run over the same Range and Window
+		Checkpoint *BulkCheckpoint
+		// OnProgress is called after every completed window. Calls are never concurrent,
+		// but they are made from the fetching goroutines and hold back the other windows until they return
+		OnProgress func(BulkProgress)
+	}
+
+	// BulkProgress reports the progress of a bulk fetcher
+	BulkProgress struct {
+		// Window is the window just completed
+		Window model.DateRange
+		// WindowsDone counts the completed windows, including those skipped through the checkpoint
+		WindowsDone int
+		// Windows is the total number of windows of the range
+		Windows int
+		// Items counts the records sent over the channel
+		Items int
+		// Duplicates counts the records dropped because a record with the same ID was already sent by the same window
+		// or an adjacent one, e.g. a record lying on the bound two windows share, which both return
+		Duplicates int
+		// Checkpoint resumes the fetch from this point when passed to BulkOptions
+		Checkpoint BulkCheckpoint
+	}
+
+	// BulkCheckpoint records the windows a bulk fetcher completed. It marshals to JSON to be persisted between runs.
+	// A window counts as completed once all its records were received from the channel, so a resumed run fetches
+	// the windows in progress again and may send some of their records twice
+	BulkCheckpoint struct {
+		// Done holds the start of every completed window
+		Done []time.Time `json:"done"`
+	}
+)
+
+// window returns the length of the windows the range is split into
+func (o BulkOptions) window() time.Duration {
+	if o.Window == 0 {
+		return defaultBulkWindow
+	}
+	return o.Window
+}
+
+// windows splits the range of the options, skipping the windows completed in the checkpoint
+func (o BulkOptions) windows() (pending []model.DateRange, done []time.Time, total int, err error) {
+	if o.Range.From.IsZero() || o.Range.To.IsZero() {
+		return nil, nil, 0, fmt.Errorf("%w: bulk fetch needs a range with both bounds", model.ErrValidation)
+	}
+	if err = o.Range.Validate(); err != nil {
+		return nil, nil, 0, err
+	}
+
+	size := o.window()
+	if size < time.Second {
+		return nil, nil, 0, fmt.Errorf("%w: bulk fetch window %s is shorter than a second", model.ErrValidation, size)
+	}
+
+	completed := make(map[int64]bool)
+	if o.Checkpoint != nil {
+		for _, start := range o.Checkpoint.Done {
+			completed[start.UnixNano()] = true
+		}
+	}
+
+	for start := o.Range.From; ; start = start.Add(size) {
+		// adjacent windows share their bound, which the API includes in both, so that no instant falls between them;
+		// only the last keeps the end of the range
+		window := model.DateRange{From: start, To: start.Add(size)}
+		last := !window.To.Before(o.Range.To)
+		if last {
+			window.To, window.ExcludeTo = o.Range.To, o.Range.ExcludeTo
+		}
+
+		total++
+		if completed[start.UnixNano()] {
+			done = append(done, start)
+		} else {
+			pending = append(pending, window)
+		}
+		if last {
+			return pending, done, total, nil
+		}
+	}
+}
+
+// bulkState tracks the records sent and the windows completed by a bulk fetcher across its workers
+type bulkState struct {
+	mu    sync.Mutex
+	start time.Time
+	size  time.Duration
+	total int
+	// seen holds the IDs sent in each window, by window index. A record is only sent twice by adjacent windows whose
+	// bounds it lies on, so the IDs of a window are dropped once the window and both its neighbours are completed
+	seen       map[int]map[string]struct{}
+	completed  map[int]bool
+	progress   BulkProgress
+	onProgress func(BulkProgress)
+}
+
+// newBulkState returns the state of a bulk fetch over the windows of the options, some of them already done
+func newBulkState(opts BulkOptions, done []time.Time, total int) *bulkState {
+	s := &bulkState{
+		start:     opts.Range.From,
+		size:      opts.window(),
+		total:     total,
+		seen:      make(map[int]map[string]struct{}),
+		completed: make(map[int]bool),
+		progress: BulkProgress{
+			WindowsDone: len(done),
+			Windows:     total,
+			Checkpoint:  BulkCheckpoint{Done: done},
+		},
+		onProgress: opts.OnProgress,
+	}
+	for _, start := range done {
+		s.completed[s.index(start)] = true
+	}
+	return s
+}
+
+// index returns the index of the window starting at the given time
+func (s *bulkState) index(start time.Time) int {
+	return int(start.Sub(s.start) / s.size)
+}
+
+// done reports whether the window of the given index needs no more lookups, whether completed or out of the range
+func (s *bulkState) done(index int) bool {
+	return index < 0 || index >= s.total || s.completed[index]
+}
+
+// first reports whether no record with the ID was sent before in the window or its neighbours, counting a duplicate
+// otherwise
+func (s *bulkState) first(window model.DateRange, id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	index := s.index(window.From)
+	for i := index - 1; i <= index+1; i++ {
+		if _, ok := s.seen[i][id]; ok {
+			s.progress.Duplicates++
+			return false
+		}
+	}
+	if s.seen[index] == nil {
+		s.seen[index] = make(map[string]struct{})
+	}
+	s.seen[index][id] = struct{}{}
+	s.progress.Items++
+	return true
+}
+
+// complete records a completed window and reports the progress
+func (s *bulkState) complete(window model.DateRange) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	index := s.index(window.From)
+	s.completed[index] = true
+	for i := index - 1; i <= index+1; i++ {
+		if s.done(i-1) && s.done(i) && s.done(i+1) {
+			delete(s.seen, i)
+		}
+	}
+
+	s.progress.Window = window
+	s.progress.WindowsDone++
+	s.progress.Checkpoint.Done = append(s.progress.Checkpoint.Done, window.From)
+	slices.SortFunc(s.progress.Checkpoint.Done, time.Time.Compare)
+
+	if s.onProgress != nil {
+		progress := s.progress
+		progress.Checkpoint.Done = slices.Clone(s.progress.Checkpoint.Done)
+		s.onProgress(progress)
+	}
+}
+
+// bulkFetch splits the range of the options into windows and lists them concurrently, sending the records over the
+// returned channel once per ID, duplicates being looked for across adjacent windows. The channel is closed when every
+// window was fetched, after the first error or when the context is done, in which case no error may be sent
+func bulkFetch[T any](ctx context.Context, opts BulkOptions, id func(T) string,
+	list func(ctx context.Context, window model.DateRange, opts ListOptions) iter.Seq2[T, error]) <-chan BulkItem[T] {
+	out := make(chan BulkItem[T])
+
+	go func() {
+		defer close(out)
+
+		fail := func(err error) {
+			if ctx.Err() != nil {
+				return
+			}
+			select {
+			case out <- BulkItem[T]{Err: err}:
+			case <-ctx.Done():
+			}
+		}
+
+		pending, done, total, err := opts.windows()
+		if err != nil {
+			fail(err)
+			return
+		}
+
+		concurrency := opts.Concurrency
+		if concurrency < 1 {
+			concurrency = defaultBulkConcurrency
+		}
+		listOpts := ListOptions{Page: model.Page{Size: helpers.GetPointerInt(defaultBulkPageSize)}}
+		if opts.PageSize > 0 {
+			listOpts.Page.Size = helpers.GetPointerInt(opts.PageSize)
+		}
+
+		state := newBulkState(opts, done, total)
+
+		workerCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		var (
+			wg       sync.WaitGroup
+			errOnce  sync.Once
+			firstErr error
+		)
+		windows := make(chan model.DateRange)
+		for range concurrency {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for window := range windows {
+					if err := fetchWindow(workerCtx, window, listOpts, state, out, id, list); err != nil {
+						errOnce.Do(func() {
+							firstErr = err
+							cancel()
+						})
+						return
+					}
+				}
+			}()
+		}
+
+	feed:
+		for _, window := range pending {
+			select {
+			case windows <- window:
+			case <-workerCtx.Done():
+				break feed
+			}
+		}
+		close(windows)
+		wg.Wait()
+
+		if firstErr != nil {
+			fail(firstErr)
+		}
+	}()
+
+	return out
+}
+
+// fetchWindow sends the records of a window that were not sent yet, then marks the window completed
+func fetchWindow[T any](ctx context.Context, window model.DateRange, opts ListOptions, state *bulkState,
+	out chan<- BulkItem[T], id func(T) string, list func(ctx context.Context, window model.DateRange, opts ListOptions) iter.Seq2[T, error]) error {
+	for item, err := range list(ctx, window, opts) {
+		if err != nil {
+			return fmt.Errorf("window %s to %s: %w", window.From.Format(model.DateRangeLayout), window.To.Format(model.DateRangeLayout), err)
+		}
+		if !state.first(window, id(item)) {
+			continue
+		}
+		select {
+		case out <- BulkItem[T]{Item: item, Window: window}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	state.complete(window)
+	return nil
+}
+
+// BulkTransactions fetches every transaction matching the filter within the range of the options, splitting it into
+// windows fetched concurrently. The Dates of the filter are replaced by each window. Transactions are sent over the
+// channel once per ID, in no particular order; cancel the context to stop early.
+//
+//	for item := range client.BulkTransactions(ctx, model.TransactionFilter{}, api.BulkOptions{Range: model.LastDays(time.Now(), 90)}) {
+//		if item.Err != nil {
+//			return item.Err
+//		}
+//		...
+//	}
+func (c *Call) BulkTransactions(ctx context.Context, filter model.TransactionFilter, opts BulkOptions) <-chan TransactionItem {
+	return bulkFetch(ctx, opts, func(transaction *model.Transaction) string {
+		return transaction.ID
+	}, func(ctx context.Context, window model.DateRange, listOpts ListOptions) iter.Seq2[*model.Transaction, error] {
+		windowFilter := filter
+		windowFilter.Dates = window
+		return c.Transactions(ctx, windowFilter, listOpts)
+	})
+}
+
+// BulkTerminalTransfers fetches every terminal transfer matching the filter within the range of the options,
+// the same way as BulkTransactions
+func (c *Call) BulkTerminalTransfers(ctx context.Context, filter model.TerminalTransferFilter, opts BulkOptions) <-chan TerminalTransferItem {
+	return bulkFetch(ctx, opts, func(transfer model.TerminalTransfer) string {
+		return transfer.ID.String()
+	}, func(ctx context.Context, window model.DateRange, listOpts ListOptions) iter.Seq2[model.TerminalTransfer, error] {
+		windowFilter := filter
+		windowFilter.Dates = window
+		return c.TerminalTransfers(ctx, windowFilter, listOpts)
+	})
+}
+
+// BulkPayouts fetches every payout matching the filter within the range of the options, the same way as BulkTransactions
+func (c *Call) BulkPayouts(ctx context.Context, filter model.PayoutFilter, opts BulkOptions) <-chan PayoutItem {
+	return bulkFetch(ctx, opts, func(payout model.PayoutDetails) string {
+		return payout.ID.String()
+	}, func(ctx context.Context, window model.DateRange, listOpts ListOptions) iter.Seq2[model.PayoutDetails, error] {
+		windowFilter := filter
+		windowFilter.Dates = window
+		return c.Payouts(ctx, windowFilter, listOpts)
+	})
+}
@@ -0,0 +1,228 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ovalfi/go-sdk/model"
+)
+
+var bulkStart = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// newBulkServer serves two transactions a day over two pages, the second being the first of the next day as if it
+// lay on the bound the windows share. The day given by failDay fails
+func newBulkServer(t *testing.T, failDay int) (*httptest.Server, func() []string) {
+	var (
+		mu       sync.Mutex
+		windows  []string
+		inFlight atomic.Int32
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.LessOrEqual(t, inFlight.Add(1), int32(2))
+		defer inFlight.Add(-1)
+
+		assert.Equal(t, "/"+transactionAPIVersion, r.URL.Path)
+		assert.Equal(t, "completed", r.URL.Query().Get("status"))
+		assert.Equal(t, "1", r.URL.Query().Get("size"))
+
+		from, err := time.Parse(model.DateRangeLayout, r.URL.Query().Get("from"))
+		require.NoError(t, err)
+		day := int(from.Sub(bulkStart) / (24 * time.Hour))
+		number, err := strconv.Atoi(r.URL.Query().Get("number"))
+		require.NoError(t, err)
+
+		if number == 1 {
+			mu.Lock()
+			windows = append(windows, r.URL.Query().Get("from")+" "+r.URL.Query().Get("to"))
+			mu.Unlock()
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if day == failDay {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":{"id":"bad_request","details":"bad request"}}`))
+			return
+		}
+
+		var response model.AllTransactionsResponse
+		response.Items.Transactions = []*model.Transaction{{ID: "tx-" + strconv.Itoa(day+number-1)}}
+		response.Page = model.PageInfo{Page: int64(number), Size: 1, HasNextPage: number < 2, TotalCount: 2}
+
+		body, err := json.Marshal(model.GenericResponse{Data: rawJSON(t, response)})
+		require.NoError(t, err)
+		_, _ = w.Write(body)
+	}))
+
+	return ts, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		sort.Strings(windows)
+		return windows
+	}
+}
+
+func collectBulk(t *testing.T, items <-chan TransactionItem) ([]string, error) {
+	t.Helper()
+	var ids []string
+	var err error
+	for item := range items {
+		if item.Err != nil {
+			err = item.Err
+			continue
+		}
+		ids = append(ids, item.Item.ID)
+	}
+	sort.Strings(ids)
+	return ids, err
+}
+
+func TestBulkTransactions(t *testing.T) {
+	ts, windows := newBulkServer(t, -1)
+	defer ts.Close()
+
+	var progress []BulkProgress
+	ids, err := collectBulk(t, newTestCall(ts.URL).BulkTransactions(context.Background(), model.TransactionFilter{Status: "completed"}, BulkOptions{
+		Range:       model.DateRange{From: bulkStart, To: bulkStart.Add(72 * time.Hour), ExcludeTo: true},
+		Concurrency: 2,
+		PageSize:    1,
+		OnProgress: func(p BulkProgress) {
+			progress = append(progress, p)
+		},
+	}))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"tx-0", "tx-1", "tx-2", "tx-3"}, ids)
+	assert.Equal(t, []string{
+		"2024-01-01T00:00:00Z 2024-01-02T00:00:00Z",
+		"2024-01-02T00:00:00Z 2024-01-03T00:00:00Z",
+		"2024-01-03T00:00:00Z 2024-01-03T23:59:59.999999999Z",
+	}, windows())
+
+	require.Len(t, progress, 3)
+	last := progress[2]
+	assert.Equal(t, 3, last.WindowsDone)
+	assert.Equal(t, 3, last.Windows)
+	assert.Equal(t, 4, last.Items)
+	assert.Equal(t, 2, last.Duplicates)
+	assert.Equal(t, []time.Time{bulkStart, bulkStart.Add(24 * time.Hour), bulkStart.Add(48 * time.Hour)}, last.Checkpoint.Done)
+}
+
+func TestBulkTransactionsResume(t *testing.T) {
+	ts, windows := newBulkServer(t, -1)
+	defer ts.Close()
+
+	// the checkpoint goes through JSON as it would between two runs
+	body, err := json.Marshal(BulkCheckpoint{Done: []time.Time{bulkStart, bulkStart.Add(48 * time.Hour)}})
+	require.NoError(t, err)
+	var checkpoint BulkCheckpoint
+	require.NoError(t, json.Unmarshal(body, &checkpoint))
+
+	var last BulkProgress
+	ids, err := collectBulk(t, newTestCall(ts.URL).BulkTransactions(context.Background(), model.TransactionFilter{Status: "completed"}, BulkOptions{
+		Range:      model.DateRange{From: bulkStart, To: bulkStart.Add(72 * time.Hour), ExcludeTo: true},
+		PageSize:   1,
+		Checkpoint: &checkpoint,
+		OnProgress: func(p BulkProgress) {
+			last = p
+		},
+	}))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"tx-1", "tx-2"}, ids)
+	assert.Equal(t, []string{"2024-01-02T00:00:00Z 2024-01-03T00:00:00Z"}, windows())
+	assert.Equal(t, 3, last.WindowsDone)
+	assert.Len(t, last.Checkpoint.Done, 3)
+}
+
+func TestBulkTransactionsError(t *testing.T) {
+	ts, _ := newBulkServer(t, 1)
+	defer ts.Close()
+
+	var last BulkProgress
+	_, err := collectBulk(t, newTestCall(ts.URL).BulkTransactions(context.Background(), model.TransactionFilter{Status: "completed"}, BulkOptions{
+		Range:       model.DateRange{From: bulkStart, To: bulkStart.Add(72 * time.Hour), ExcludeTo: true},
+		Concurrency: 1,
+		PageSize:    1,
+		OnProgress: func(p BulkProgress) {
+			last = p
+		},
+	}))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "window 2024-01-02T00:00:00Z")
+	// the failed window and those after it are left out of the checkpoint
+	assert.Equal(t, []time.Time{bulkStart}, last.Checkpoint.Done)
+}
+
+func TestBulkTransactionsInvalidOptions(t *testing.T) {
+	call := newTestCall("http://localhost")
+
+	for name, opts := range map[string]BulkOptions{
+		"open range":   {Range: model.DateRange{From: bulkStart}},
+		"short window": {Range: model.LastDays(bulkStart, 2), Window: time.Millisecond},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := collectBulk(t, call.BulkTransactions(context.Background(), model.TransactionFilter{}, opts))
+			assert.ErrorIs(t, err, model.ErrValidation)
+		})
+	}
+}
+
+func TestBulkTransactionsCancel(t *testing.T) {
+	ts, _ := newBulkServer(t, -1)
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	items := newTestCall(ts.URL).BulkTransactions(ctx, model.TransactionFilter{Status: "completed"}, BulkOptions{
+		Range:       model.DateRange{From: bulkStart, To: bulkStart.Add(30 * 24 * time.Hour)},
+		Concurrency: 2,
+		PageSize:    1,
+	})
+	<-items
+	cancel()
+
+	// the channel is closed without the remaining windows
+	count := 0
+	for range items {
+		count++
+	}
+	assert.Less(t, count, 30)
+}
+
+func TestBulkStateForgetsCompletedWindows(t *testing.T) {
+	day := 24 * time.Hour
+	window := func(i int) model.DateRange {
+		from := bulkStart.Add(time.Duration(i) * day)
+		return model.DateRange{From: from, To: from.Add(day), ExcludeTo: true}
+	}
+	state := newBulkState(BulkOptions{Range: model.DateRange{From: bulkStart, To: bulkStart.Add(4 * day)}}, nil, 4)
+
+	assert.True(t, state.first(window(0), "tx-0"))
+	assert.True(t, state.first(window(0), "tx-1"))
+	assert.True(t, state.first(window(2), "tx-2"))
+	assert.False(t, state.first(window(1), "tx-1"))
+	assert.True(t, state.first(window(3), "tx-1"), "windows two apart do not share records")
+
+	// the IDs of a window are kept until its neighbours are completed
+	state.complete(window(0))
+	assert.Len(t, state.seen, 3)
+	state.complete(window(1))
+	assert.NotContains(t, state.seen, 0)
+	assert.Contains(t, state.seen, 2)
+	state.complete(window(2))
+	state.complete(window(3))
+	assert.Empty(t, state.seen)
+	assert.Equal(t, 1, state.progress.Duplicates)
+}
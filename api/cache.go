@@ -0,0 +1,220 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/ovalfi/go-sdk/model"
+)
+
+// cacheFetchTimeout bounds the fetches of cached responses when the timeout policy sets none, since they do not
+// share the deadline of their callers
+const cacheFetchTimeout = 30 * time.Second
+
+// CacheSettings configures the cache of reference data responses
+type CacheSettings struct {
+	// TTLs maps the RemoteCalls methods whose responses are cached to how long they stay fresh. Other methods are never cached
+	TTLs map[string]time.Duration
+	// StaleWhileRevalidate is how long an expired response is still served, while it is refreshed in the background
+	StaleWhileRevalidate time.Duration
+}
+
+// DefaultCacheSettings caches the reference data that rarely changes
+var DefaultCacheSettings = CacheSettings{
+	TTLs: map[string]time.Duration{
+		"GetBanks":            24 * time.Hour,
+		"GetSupportedBanks":   24 * time.Hour,
+		"GetSupportedAssets":  time.Hour,
+		"GetBillerCategories": 6 * time.Hour,
+		"GetBillers":          6 * time.Hour,
+		"GetPayoutConfig":     time.Hour,
+		"GetCompetitorsRates": 5 * time.Minute,
+	},
+	StaleWhileRevalidate: time.Minute,
+}
+
+// WithReferenceDataCache caches the responses of the methods listed in the settings. Concurrent identical requests
+// are coalesced into a single call to the API, and errors are never cached.
+//
+// A ResponseInfo passed with the context of a cached call is filled only when the call waited for the API,
+// it is left untouched when the response is served from the cache
+func WithReferenceDataCache(settings CacheSettings) Option {
+	return func(o *options) {
+		o.cache = &settings
+	}
+}
+
+// cacheResult tells how a cached call was served
+type cacheResult string
+
+const (
+	// cacheHit is a call served from the cached data, fresh or stale
+	cacheHit cacheResult = "hit"
+	// cacheMiss is a call that started the fetch of the data
+	cacheMiss cacheResult = "miss"
+	// cacheShared is a call that waited for a fetch started by another call
+	cacheShared cacheResult = "shared"
+)
+
+type (
+	// responseCache holds the data of the cached responses, keyed by method, path and query parameters
+	responseCache struct {
+		settings CacheSettings
+		now      func() time.Time
+
+		mu         sync.Mutex
+		entries    map[string]*cacheEntry
+		generation uint64
+	}
+
+	// cacheEntry is the cached data of a request and its fetch in flight, if any
+	cacheEntry struct {
+		operation string
+		data      json.RawMessage
+		expires   time.Time
+		fetch     *cacheFetch
+	}
+
+	// cacheFetch is a call to the API shared by every request waiting for the same entry
+	cacheFetch struct {
+		done chan struct{}
+		data json.RawMessage
+		err  error
+		info ResponseInfo
+	}
+)
+
+// newResponseCache returns the cache described by the settings, nil when caching is off
+func newResponseCache(settings *CacheSettings) *responseCache {
+	if settings == nil {
+		return nil
+	}
+	return &responseCache{
+		settings: *settings,
+		now:      time.Now,
+		entries:  make(map[string]*cacheEntry),
+	}
+}
+
+// InvalidateReferenceData drops the cached responses of the given methods, or of every method when none is given
+func (c *Call) InvalidateReferenceData(operations ...string) {
+	c.cache.invalidate(operations...)
+}
+
+// ttl returns how long the response of a request stays fresh, 0 when it is not cached
+func (r *responseCache) ttl(request *Request) time.Duration {
+	if r == nil || request.Method != http.MethodGet {
+		return 0
+	}
+	return r.settings.TTLs[request.Operation]
+}
+
+// get returns the cached data of a request, fetching it when missing or expired. Data expired for less than
+// StaleWhileRevalidate is returned as is while a single background fetch refreshes it. Callers waiting for a fetch
+// give up when their own context is done, without cancelling the fetch
+func (r *responseCache) get(ctx context.Context, request *Request, ttl time.Duration, fetch func(context.Context) (json.RawMessage, error)) (json.RawMessage, cacheResult, error) {
+	key := cacheKey(request)
+	now := r.now()
+
+	r.mu.Lock()
+	entry, ok := r.entries[key]
+	if !ok {
+		entry = &cacheEntry{operation: request.Operation}
+		r.entries[key] = entry
+	}
+
+	if entry.data != nil && now.Before(entry.expires) {
+		r.mu.Unlock()
+		return entry.data, cacheHit, nil
+	}
+	if entry.data != nil && now.Before(entry.expires.Add(r.settings.StaleWhileRevalidate)) {
+		if entry.fetch == nil {
+			r.start(ctx, entry, ttl, fetch)
+		}
+		data := entry.data
+		r.mu.Unlock()
+		return data, cacheHit, nil
+	}
+
+	pending, result := entry.fetch, cacheShared
+	if pending == nil {
+		pending, result = r.start(ctx, entry, ttl, fetch), cacheMiss
+	}
+	r.mu.Unlock()
+
+	select {
+	case <-pending.done:
+		if info := responseInfoFrom(ctx); info != nil {
+			*info = pending.info
+			info.Header = pending.info.Header.Clone()
+		}
+		return pending.data, result, pending.err
+	case <-ctx.Done():
+		return nil, result, ctx.Err()
+	}
+}
+
+// start fetches the data of an entry in the background. It must be called with the lock held.
+// The fetch keeps the values of the context that started it but outlives it, and records its response metadata
+// for the callers waiting for it
+func (r *responseCache) start(ctx context.Context, entry *cacheEntry, ttl time.Duration, fetch func(context.Context) (json.RawMessage, error)) *cacheFetch {
+	pending := &cacheFetch{done: make(chan struct{})}
+	entry.fetch = pending
+	generation := r.generation
+	ctx = context.WithValue(context.WithoutCancel(ctx), model.ResponseInfoContextKey, &pending.info)
+
+	go func() {
+		data, err := fetch(ctx)
+
+		r.mu.Lock()
+		entry.fetch = nil
+		// the data of a fetch started before an invalidation is not kept
+		if err == nil && generation == r.generation {
+			entry.data = data
+			entry.expires = r.now().Add(ttl)
+		}
+		r.mu.Unlock()
+
+		pending.data, pending.err = data, err
+		close(pending.done)
+	}()
+
+	return pending
+}
+
+func (r *responseCache) invalidate(operations ...string) {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.generation++
+	if len(operations) == 0 {
+		r.entries = make(map[string]*cacheEntry)
+		return
+	}
+	for key, entry := range r.entries {
+		for _, operation := range operations {
+			if entry.operation == operation {
+				delete(r.entries, key)
+				break
+			}
+		}
+	}
+}
+
+// cacheKey identifies a request by its method, path and query parameters
+func cacheKey(request *Request) string {
+	query := make(url.Values, len(request.Params))
+	for key, value := range request.Params {
+		query.Set(key, fmt.Sprint(value))
+	}
+	return request.Method + " " + request.Path + "?" + query.Encode()
+}
@@ -0,0 +1,219 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newCachedCall returns a client caching with the default settings, whose clock is moved by the returned function
+func newCachedCall(url string) (*Call, func(time.Duration)) {
+	call := newTestCall(url)
+	call.cache = newResponseCache(&DefaultCacheSettings)
+
+	var offset atomic.Int64
+	start := time.Now()
+	call.cache.now = func() time.Time {
+		return start.Add(time.Duration(offset.Load()))
+	}
+	return call, func(d time.Duration) {
+		offset.Add(int64(d))
+	}
+}
+
+func TestReferenceDataCache(t *testing.T) {
+	var calls atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/"+customerTransferAPIVersion+"/quote" {
+			_, _ = w.Write([]byte(`{"data":{"rate":1500}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":[{"provider":"` + r.URL.Query().Get("from") + `","rate":1500}]}`))
+	}))
+	defer ts.Close()
+
+	call, advance := newCachedCall(ts.URL)
+	ctx := context.Background()
+
+	rates, err := call.GetCompetitorsRates(ctx, "USD", "NGN")
+	require.NoError(t, err)
+	rates[0].Rate = 0
+
+	rates, err = call.GetCompetitorsRates(ctx, "USD", "NGN")
+	require.NoError(t, err)
+	assert.Equal(t, 1500.0, rates[0].Rate, "callers must not share the cached values")
+	assert.Equal(t, int32(1), calls.Load())
+
+	rates, err = call.GetCompetitorsRates(ctx, "GBP", "NGN")
+	require.NoError(t, err)
+	assert.Equal(t, "GBP", rates[0].Provider)
+	assert.Equal(t, int32(2), calls.Load())
+
+	// other methods are not cached
+	_, err = call.GetExchangeRates(ctx, 100, "USD", "NGN")
+	require.NoError(t, err)
+	_, err = call.GetExchangeRates(ctx, 100, "USD", "NGN")
+	require.NoError(t, err)
+	assert.Equal(t, int32(4), calls.Load())
+
+	advance(10 * time.Minute)
+	_, err = call.GetCompetitorsRates(ctx, "USD", "NGN")
+	require.NoError(t, err)
+	assert.Equal(t, int32(5), calls.Load())
+
+	call.InvalidateReferenceData("GetBanks")
+	_, err = call.GetCompetitorsRates(ctx, "USD", "NGN")
+	require.NoError(t, err)
+	assert.Equal(t, int32(5), calls.Load())
+
+	call.InvalidateReferenceData("GetCompetitorsRates")
+	_, err = call.GetCompetitorsRates(ctx, "USD", "NGN")
+	require.NoError(t, err)
+	assert.Equal(t, int32(6), calls.Load())
+
+	call.InvalidateReferenceData()
+	_, err = call.GetCompetitorsRates(ctx, "GBP", "NGN")
+	require.NoError(t, err)
+	assert.Equal(t, int32(7), calls.Load())
+}
+
+func TestReferenceDataCacheCoalescing(t *testing.T) {
+	var calls atomic.Int32
+	release := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":[{"code":"044","name":"Access Bank"}]}`))
+	}))
+	defer ts.Close()
+
+	call, _ := newCachedCall(ts.URL)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			banks, err := call.GetBanks(context.Background())
+			assert.NoError(t, err)
+			assert.Len(t, banks, 1)
+		}()
+	}
+
+	assert.Eventually(t, func() bool { return calls.Load() == 1 }, time.Second, time.Millisecond)
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestReferenceDataCacheStaleWhileRevalidate(t *testing.T) {
+	var calls atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		if n == 3 {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":{"id":"bad_request","details":"bad request"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"provider":"` + map[int32]string{1: "NGN", 2: "KES", 4: "GHS"}[n] + `"}}`))
+	}))
+	defer ts.Close()
+
+	call, advance := newCachedCall(ts.URL)
+	ctx := context.Background()
+
+	config, err := call.GetPayoutConfig(ctx, "NGN")
+	require.NoError(t, err)
+	assert.Equal(t, "NGN", config.Provider)
+
+	// expired but within the stale window: the stale config is served and refreshed in the background
+	advance(time.Hour + 30*time.Second)
+	config, err = call.GetPayoutConfig(ctx, "NGN")
+	require.NoError(t, err)
+	assert.Equal(t, "NGN", config.Provider)
+	assert.Eventually(t, func() bool {
+		config, err = call.GetPayoutConfig(ctx, "NGN")
+		return err == nil && config.Provider == "KES"
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, int32(2), calls.Load())
+
+	// past the stale window the request waits for the API, and errors are not cached
+	advance(2 * time.Hour)
+	_, err = call.GetPayoutConfig(ctx, "NGN")
+	require.Error(t, err)
+	config, err = call.GetPayoutConfig(ctx, "NGN")
+	require.NoError(t, err)
+	assert.Equal(t, "GHS", config.Provider)
+	assert.Equal(t, int32(4), calls.Load())
+}
+
+func TestReferenceDataCacheLeaderCancelled(t *testing.T) {
+	var calls atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		time.Sleep(100 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":[{"code":"044","name":"Access Bank"}]}`))
+	}))
+	defer ts.Close()
+
+	call, _ := newCachedCall(ts.URL)
+
+	leader, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, err := call.GetBanks(leader)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	}()
+	assert.Eventually(t, func() bool { return calls.Load() == 1 }, time.Second, time.Millisecond)
+
+	// the follower shares the fetch started by the leader, which outlives its deadline
+	banks, err := call.GetBanks(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, banks, 1)
+	wg.Wait()
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestReferenceDataCacheResponseInfo(t *testing.T) {
+	var calls atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"provider":"NGN"}}`))
+	}))
+	defer ts.Close()
+
+	call, advance := newCachedCall(ts.URL)
+
+	var fetched ResponseInfo
+	_, err := call.GetPayoutConfig(WithResponseInfo(context.Background(), &fetched), "NGN")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, fetched.StatusCode)
+	assert.Equal(t, 1, fetched.Attempts)
+
+	// the background refresh of a stale response does not report to the caller served from the cache
+	advance(time.Hour + 30*time.Second)
+	var stale ResponseInfo
+	_, err = call.GetPayoutConfig(WithResponseInfo(context.Background(), &stale), "NGN")
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool { return calls.Load() == 2 }, time.Second, time.Millisecond)
+	assert.Equal(t, ResponseInfo{}, stale)
+}
@@ -3,11 +3,9 @@ package api
 import (
 	"context"
 	"fmt"
-	"net/url"
 
 	"net/http"
 
-	"github.com/ovalfi/go-sdk/helpers"
 	"github.com/ovalfi/go-sdk/model"
 )
 
@@ -17,10 +15,10 @@ func (c *Call) CreateCustomerCard(ctx context.Context, request model.CreateCusto
 		err       error
 		response  string
 		path      = "v1/cards"
-		signature = helpers.GetSignatureFromReferenceAndPubKey(request.Reference, c.apiSecret)
+		reference = request.Reference
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodPost, &signature, nil, nil, request, &response)
+	err = c.makeRequest(ctx, "CreateCustomerCard", path, http.MethodPost, &reference, nil, nil, request, &response)
 	return response, err
 }
 
@@ -30,10 +28,10 @@ func (c *Call) CreateCustomerCardV2(ctx context.Context, request model.CreateCus
 		err       error
 		response  string
 		path      = "v1/cards/new"
-		signature = helpers.GetSignatureFromReferenceAndPubKey(request.Reference, c.apiSecret)
+		reference = request.Reference
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodPost, &signature, nil, nil, request, &response)
+	err = c.makeRequest(ctx, "CreateCustomerCardV2", path, http.MethodPost, &reference, nil, nil, request, &response)
 	return response, err
 }
 
@@ -45,7 +43,7 @@ func (c *Call) FreezeUnfreezeCard(ctx context.Context, request model.FreezeCardR
 		path     = "v1/cards/freeze"
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodPost, nil, nil, nil, request, &response)
+	err = c.makeRequest(ctx, "FreezeUnfreezeCard", path, http.MethodPost, nil, nil, nil, request, &response)
 	return response, err
 }
 
@@ -60,7 +58,7 @@ func (c *Call) GetCustomerCards(ctx context.Context, customerID *string) (model.
 	if customerID != nil {
 		path = fmt.Sprintf("v1/cards?customer_id=%s", *customerID)
 	}
-	err = c.makeRequest(ctx, path, http.MethodGet, nil, nil, nil, nil, &response)
+	err = c.makeRequest(ctx, "GetCustomerCards", path, http.MethodGet, nil, nil, nil, nil, &response)
 	return response, err
 }
 
@@ -72,7 +70,7 @@ func (c *Call) GetCustomerCardByID(ctx context.Context, cardID string) (model.Ca
 		path     = fmt.Sprintf("v1/cards/%s", cardID)
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodGet, nil, nil, nil, nil, &response)
+	err = c.makeRequest(ctx, "GetCustomerCardByID", path, http.MethodGet, nil, nil, nil, nil, &response)
 	return response, err
 }
 
@@ -84,7 +82,7 @@ func (c *Call) FundCustomerCard(ctx context.Context, request model.FundCustomerC
 		path     = "v1/cards/fund"
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodPost, nil, nil, nil, request, &response)
+	err = c.makeRequest(ctx, "FundCustomerCard", path, http.MethodPost, nil, nil, nil, request, &response)
 	return response, err
 }
 
@@ -93,15 +91,16 @@ func (c *Call) GetCustomerCardSecureDetails(ctx context.Context, cardID, custome
 	var (
 		err      error
 		response model.VaultedCardDetails
+		params   = map[string]interface{}{"customer_id": customerID}
+		path     = fmt.Sprintf("v1/cards/%s/secure", cardID)
 	)
 
-	path := fmt.Sprintf("v1/cards/%s/secure?customer_id=%s", cardID, customerID)
+	// the nonce key travels as a parameter rather than in the path so that it is redacted from the logs
 	if nonceKey != "" {
-		escapedNonce := url.QueryEscape(nonceKey)
-		path = fmt.Sprintf("%s&nonce_key=%s", path, escapedNonce)
+		params["nonce_key"] = nonceKey
 	}
 
-	err = c.makeRequest(ctx, path, http.MethodGet, nil, nil, nil, nil, &response)
+	err = c.makeRequest(ctx, "GetCustomerCardSecureDetails", path, http.MethodGet, nil, params, nil, nil, &response)
 	return response, err
 }
 
@@ -113,7 +112,7 @@ func (c *Call) DeleteCard(ctx context.Context, cardID, customerID string) (strin
 		path     = fmt.Sprintf("v1/cards/%s?customer_id=%s", cardID, customerID)
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodDelete, nil, nil, nil, nil, &response)
+	err = c.makeRequest(ctx, "DeleteCard", path, http.MethodDelete, nil, nil, nil, nil, &response)
 	return response, err
 }
 
@@ -125,7 +124,7 @@ func (c *Call) InitiateCustomerPaymentSession(ctx context.Context, request model
 		path     = fmt.Sprintf("v1/payments/sessions")
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodPost, nil, nil, nil, request, &response)
+	err = c.makeRequest(ctx, "InitiateCustomerPaymentSession", path, http.MethodPost, nil, nil, nil, request, &response)
 
 	return response, err
 }
@@ -138,7 +137,7 @@ func (c *Call) ProcessCustomerPaymentToken(ctx context.Context, request model.Cu
 		path     = fmt.Sprintf("v1/payments/sessions/token/process")
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodPost, nil, nil, nil, request, response)
+	err = c.makeRequest(ctx, "ProcessCustomerPaymentToken", path, http.MethodPost, nil, nil, nil, request, response)
 
 	return err
 }
@@ -154,7 +153,7 @@ func (c *Call) GetCardEndorsementLink(ctx context.Context, customerID string) (m
 		path = "v1/cards/endorsement-link"
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodGet, nil, params, nil, nil, &response)
+	err = c.makeRequest(ctx, "GetCardEndorsementLink", path, http.MethodGet, nil, params, nil, nil, &response)
 
 	return response, err
 }
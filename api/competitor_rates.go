@@ -20,6 +20,6 @@ func (c *Call) GetCompetitorsRates(ctx context.Context, from, to string) ([]mode
 		path = fmt.Sprintf("%s/competitors-rates", utilAPIVersion)
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodGet, nil, params, nil, nil, &response)
+	err = c.makeRequest(ctx, "GetCompetitorsRates", path, http.MethodGet, nil, params, nil, nil, &response)
 	return response, err
 }
@@ -40,7 +40,7 @@ func TestGetCompetitorsRates(t *testing.T) {
 		assert.Equal(t, "USD", r.URL.Query().Get("from"))
 		assert.Equal(t, "NGN", r.URL.Query().Get("to"))
 
-		body, err := json.Marshal(model.GenericResponse{Data: expected})
+		body, err := json.Marshal(model.GenericResponse{Data: rawJSON(t, expected)})
 		assert.NoError(t, err)
 
 		w.Header().Set("Content-Type", "application/json")
@@ -54,7 +54,7 @@ func TestGetCompetitorsRates(t *testing.T) {
 		baseURL:     ts.URL + "/",
 		client:      resty.New(),
 		logger:      zerolog.Nop(),
-		bearerToken: "test-token",
+		credentials: StaticCredentials("", "test-token"),
 	}
 
 	rates, err := call.GetCompetitorsRates(context.Background(), "USD", "NGN")
@@ -0,0 +1,209 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+type (
+	// Credentials are the API secret used to sign requests and the bearer token used to authenticate them
+	Credentials struct {
+		APISecret   string `json:"api_secret" sensitive:"secret"`
+		BearerToken string `json:"bearer_token" sensitive:"token"`
+	}
+
+	// CredentialsProvider supplies the credentials of the client. It is consulted before every request,
+	// so rotated credentials are picked up without rebuilding the client
+	CredentialsProvider interface {
+		// Credentials returns the credentials to send the next request with
+		Credentials(ctx context.Context) (Credentials, error)
+		// Refresh is called when the API rejects the credentials with a 401. The request is sent once more
+		// when the credentials returned afterwards differ from the rejected ones
+		Refresh(ctx context.Context) error
+	}
+
+	// staticCredentials never change
+	staticCredentials Credentials
+
+	// envCredentials read the credentials from environment variables on every request
+	envCredentials struct {
+		apiSecretVar   string
+		bearerTokenVar string
+	}
+
+	// FileCredentials reads the credentials from a JSON file, reloading it whenever it changes on disk
+	FileCredentials struct {
+		path string
+		now  func() time.Time
+
+		// mu serializes the checks and reloads of the file, requests read the snapshot without locking
+		mu       sync.Mutex
+		snapshot atomic.Pointer[fileSnapshot]
+	}
+
+	// fileSnapshot is the content of a credentials file as last loaded
+	fileSnapshot struct {
+		credentials Credentials
+		modTime     time.Time
+		size        int64
+		checkedAt   time.Time
+	}
+)
+
+// fileCheckInterval is how long FileCredentials serves the loaded credentials before checking the file for changes again
+const fileCheckInterval = time.Second
+
+// WithCredentialsProvider sets the source of the credentials, taking precedence over WithCredentials
+func WithCredentialsProvider(provider CredentialsProvider) Option {
+	return func(o *options) {
+		o.credentials = provider
+	}
+}
+
+// StaticCredentials returns a provider that always returns the same credentials
+func StaticCredentials(apiSecret, bearerToken string) CredentialsProvider {
+	return staticCredentials{APISecret: apiSecret, BearerToken: bearerToken}
+}
+
+// Credentials implements CredentialsProvider
+func (s staticCredentials) Credentials(context.Context) (Credentials, error) {
+	return Credentials(s), nil
+}
+
+// Refresh implements CredentialsProvider
+func (staticCredentials) Refresh(context.Context) error {
+	return nil
+}
+
+// EnvCredentials returns a provider reading the API secret and the bearer token from the given environment variables
+func EnvCredentials(apiSecretVar, bearerTokenVar string) CredentialsProvider {
+	return envCredentials{apiSecretVar: apiSecretVar, bearerTokenVar: bearerTokenVar}
+}
+
+// Credentials implements CredentialsProvider
+func (e envCredentials) Credentials(context.Context) (Credentials, error) {
+	credentials := Credentials{
+		APISecret:   os.Getenv(e.apiSecretVar),
+		BearerToken: os.Getenv(e.bearerTokenVar),
+	}
+	if credentials.APISecret == "" || credentials.BearerToken == "" {
+		return credentials, fmt.Errorf("%s, %s: %w", e.apiSecretVar, e.bearerTokenVar, ErrMissingCredentials)
+	}
+	return credentials, nil
+}
+
+// Refresh implements CredentialsProvider, the variables are read on every request anyway
+func (envCredentials) Refresh(context.Context) error {
+	return nil
+}
+
+// NewFileCredentials returns a provider reading the credentials from a JSON file holding api_secret and bearer_token.
+// Replace the file atomically, e.g. by renaming a new file over it, when rotating the credentials
+func NewFileCredentials(path string) (*FileCredentials, error) {
+	f := &FileCredentials{path: path, now: time.Now}
+	if err := f.load(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// Credentials implements CredentialsProvider. The file is checked at most once per second, by a single request,
+// and reloaded when its modification time or size changed; the other requests get the loaded credentials meanwhile
+func (f *FileCredentials) Credentials(context.Context) (Credentials, error) {
+	snapshot := f.snapshot.Load()
+	if f.now().Sub(snapshot.checkedAt) < fileCheckInterval || !f.mu.TryLock() {
+		return snapshot.credentials, nil
+	}
+	defer f.mu.Unlock()
+
+	snapshot = f.snapshot.Load()
+	if f.now().Sub(snapshot.checkedAt) < fileCheckInterval {
+		return snapshot.credentials, nil
+	}
+
+	info, err := os.Stat(f.path)
+	if err == nil && (!info.ModTime().Equal(snapshot.modTime) || info.Size() != snapshot.size) && f.loadLocked() == nil {
+		return f.snapshot.Load().credentials, nil
+	}
+
+	// keep serving the previous credentials when the file is missing or being rewritten
+	checked := *snapshot
+	checked.checkedAt = f.now()
+	f.snapshot.Store(&checked)
+	return checked.credentials, nil
+}
+
+// Refresh implements CredentialsProvider by reloading the file
+func (f *FileCredentials) Refresh(context.Context) error {
+	return f.load()
+}
+
+func (f *FileCredentials) load() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.loadLocked()
+}
+
+func (f *FileCredentials) loadLocked() error {
+	file, err := os.Open(f.path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	var credentials Credentials
+	if err = json.NewDecoder(file).Decode(&credentials); err != nil {
+		return fmt.Errorf("%s: %w", f.path, err)
+	}
+	if credentials.APISecret == "" || credentials.BearerToken == "" {
+		return fmt.Errorf("%s: %w", f.path, ErrMissingCredentials)
+	}
+
+	f.snapshot.Store(&fileSnapshot{
+		credentials: credentials,
+		modTime:     info.ModTime(),
+		size:        info.Size(),
+		checkedAt:   f.now(),
+	})
+	return nil
+}
+
+// currentCredentials returns the credentials to send a request with, none when the client has no provider
+func (c *Call) currentCredentials(ctx context.Context) (Credentials, error) {
+	if c.credentials == nil {
+		return Credentials{}, nil
+	}
+
+	credentials, err := c.credentials.Credentials(ctx)
+	if err != nil {
+		return credentials, fmt.Errorf("loading credentials: %w", err)
+	}
+	return credentials, nil
+}
+
+// refreshCredentials asks the provider for new credentials after the API rejected the used ones,
+// reporting whether they changed so that the request is worth sending again
+func (c *Call) refreshCredentials(ctx context.Context, log zerolog.Logger, used Credentials) bool {
+	if c.credentials == nil {
+		return false
+	}
+	if err := c.credentials.Refresh(ctx); err != nil {
+		log.Err(err).Msg("error while refreshing credentials")
+		return false
+	}
+
+	credentials, err := c.credentials.Credentials(ctx)
+	return err == nil && credentials != used
+}
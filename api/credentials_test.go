@@ -0,0 +1,221 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ovalfi/go-sdk/helpers"
+	"github.com/ovalfi/go-sdk/model"
+)
+
+// rotatingCredentials hands out the next credentials whenever it is refreshed
+type rotatingCredentials struct {
+	mu        sync.Mutex
+	sequence  []Credentials
+	refreshes int
+}
+
+func (r *rotatingCredentials) Credentials(context.Context) (Credentials, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.sequence[0], nil
+}
+
+func (r *rotatingCredentials) Refresh(context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.refreshes++
+	if len(r.sequence) > 1 {
+		r.sequence = r.sequence[1:]
+	}
+	return nil
+}
+
+func TestCredentialsProviderPerRequest(t *testing.T) {
+	var tokens, signatures []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tokens = append(tokens, r.Header.Get("Authorization"))
+		signatures = append(signatures, r.Header.Get("Signature"))
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"data":{"reference":"ref-1"}}`))
+	}))
+	defer ts.Close()
+
+	provider := &rotatingCredentials{sequence: []Credentials{{"s1", "t1"}, {"s2", "t2"}}}
+	client, err := NewClient(WithBaseURL(ts.URL+"/"), WithCredentialsProvider(provider))
+	require.NoError(t, err)
+
+	_, err = client.InitiateDeposit(context.Background(), model.InitiateDepositRequest{Reference: "ref-1"})
+	require.NoError(t, err)
+	require.NoError(t, provider.Refresh(context.Background()))
+	_, err = client.InitiateDeposit(context.Background(), model.InitiateDepositRequest{Reference: "ref-1"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"Bearer t1", "Bearer t2"}, tokens)
+	assert.Equal(t, []string{
+		helpers.GetSignatureFromReferenceAndPubKey("ref-1", "s1"),
+		helpers.GetSignatureFromReferenceAndPubKey("ref-1", "s2"),
+	}, signatures)
+}
+
+func TestCredentialsRefreshOnUnauthorized(t *testing.T) {
+	tests := map[string]struct {
+		sequence          []Credentials
+		validToken        string
+		status            int
+		expectedHits      int
+		expectedErr       error
+		expectedRefreshes int
+	}{
+		"rotated credentials are retried once": {
+			sequence:          []Credentials{{"s1", "old"}, {"s1", "new"}},
+			validToken:        "Bearer new",
+			expectedHits:      2,
+			expectedRefreshes: 1,
+		},
+		"unchanged credentials are not retried": {
+			sequence:          []Credentials{{"s1", "old"}},
+			validToken:        "Bearer new",
+			expectedHits:      1,
+			expectedErr:       model.ErrUnauthorized,
+			expectedRefreshes: 1,
+		},
+		"refreshed credentials rejected again": {
+			sequence:          []Credentials{{"s1", "old"}, {"s1", "older"}, {"s1", "new"}},
+			validToken:        "Bearer new",
+			expectedHits:      2,
+			expectedErr:       model.ErrUnauthorized,
+			expectedRefreshes: 1,
+		},
+		"forbidden is not refreshed": {
+			sequence:     []Credentials{{"s1", "old"}, {"s1", "new"}},
+			validToken:   "Bearer new",
+			status:       http.StatusForbidden,
+			expectedHits: 1,
+			expectedErr:  model.ErrUnauthorized,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			hits := 0
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				hits++
+				w.Header().Set("Content-Type", "application/json")
+				if r.Header.Get("Authorization") != tt.validToken {
+					status := tt.status
+					if status == 0 {
+						status = http.StatusUnauthorized
+					}
+					w.WriteHeader(status)
+					_, _ = w.Write([]byte(`{"error":{"id":"unauthorized","details":"invalid token"}}`))
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`{"data":{"account_name":"Ada"}}`))
+			}))
+			defer ts.Close()
+
+			provider := &rotatingCredentials{sequence: tt.sequence}
+			client, err := NewClient(WithBaseURL(ts.URL+"/"), WithCredentialsProvider(provider))
+			require.NoError(t, err)
+
+			_, err = client.ResolveBankAccount(context.Background(), model.AccountResolveRequest{BankCode: "058"})
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedHits, hits)
+			assert.Equal(t, tt.expectedRefreshes, provider.refreshes)
+		})
+	}
+}
+
+func TestFileCredentials(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "credentials.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"api_secret":"s1","bearer_token":"t1"}`), 0o600))
+
+	provider, err := NewFileCredentials(path)
+	require.NoError(t, err)
+	now := time.Now()
+	provider.now = func() time.Time { return now }
+
+	credentials, err := provider.Credentials(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, Credentials{APISecret: "s1", BearerToken: "t1"}, credentials)
+
+	// the file is not checked again before the interval elapsed
+	require.NoError(t, os.WriteFile(path, []byte(`{"api_secret":"s2","bearer_token":"t22"}`), 0o600))
+	credentials, err = provider.Credentials(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, Credentials{APISecret: "s1", BearerToken: "t1"}, credentials)
+
+	now = now.Add(fileCheckInterval)
+	credentials, err = provider.Credentials(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, Credentials{APISecret: "s2", BearerToken: "t22"}, credentials)
+
+	// a half written file keeps the previous credentials in use
+	require.NoError(t, os.WriteFile(path, []byte(`{"api_secret":`), 0o600))
+	require.NoError(t, os.Chtimes(path, time.Now(), time.Now().Add(time.Second)))
+	now = now.Add(fileCheckInterval)
+	credentials, err = provider.Credentials(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, Credentials{APISecret: "s2", BearerToken: "t22"}, credentials)
+	assert.Error(t, provider.Refresh(context.Background()))
+
+	// a refresh reloads the file right away
+	require.NoError(t, os.WriteFile(path, []byte(`{"api_secret":"s3","bearer_token":"t3"}`), 0o600))
+	require.NoError(t, provider.Refresh(context.Background()))
+	credentials, err = provider.Credentials(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, Credentials{APISecret: "s3", BearerToken: "t3"}, credentials)
+
+	_, err = NewFileCredentials(filepath.Join(t.TempDir(), "missing.json"))
+	assert.Error(t, err)
+}
+
+func TestFileCredentialsConcurrentReads(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "credentials.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"api_secret":"s1","bearer_token":"t1"}`), 0o600))
+
+	provider, err := NewFileCredentials(path)
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				credentials, err := provider.Credentials(context.Background())
+				assert.NoError(t, err)
+				assert.Equal(t, "s1", credentials.APISecret)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestEnvCredentials(t *testing.T) {
+	provider := EnvCredentials("OVALFI_TEST_API_SECRET", "OVALFI_TEST_BEARER_TOKEN")
+
+	_, err := provider.Credentials(context.Background())
+	assert.ErrorIs(t, err, ErrMissingCredentials)
+
+	t.Setenv("OVALFI_TEST_API_SECRET", "secret")
+	t.Setenv("OVALFI_TEST_BEARER_TOKEN", "token")
+	credentials, err := provider.Credentials(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, Credentials{APISecret: "secret", BearerToken: "token"}, credentials)
+}
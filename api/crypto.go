@@ -19,7 +19,7 @@ func (c *Call) GetCustomerWallet(ctx context.Context, request model.CustomerWall
 		path     = fmt.Sprintf("%s/wallet", cryptoAPIVersion)
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodGet, nil, params, nil, nil, &response)
+	err = c.makeRequest(ctx, "GetCustomerWallet", path, http.MethodGet, nil, params, nil, nil, &response)
 
 	return response, err
 }
@@ -31,6 +31,6 @@ func (c *Call) GetSupportedAssets(ctx context.Context) ([]*model.SupportedCurren
 		response []*model.SupportedCurrencies
 		path     = "v1/supported-assets"
 	)
-	err = c.makeRequest(ctx, path, http.MethodGet, nil, nil, nil, nil, &response)
+	err = c.makeRequest(ctx, "GetSupportedAssets", path, http.MethodGet, nil, nil, nil, nil, &response)
 	return response, err
 }
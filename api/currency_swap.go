@@ -19,13 +19,18 @@ func (c *Call) InitiateCurrencySwap(ctx context.Context, request model.InitiateC
 		path     = currencySwapAPIVersion
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodPost, nil, nil, nil, request, &response)
+	err = c.makeRequest(ctx, "InitiateCurrencySwap", path, http.MethodPost, nil, nil, nil, request, &response)
 
 	return response, err
 }
 
-// GetCurrencySwaps makes a request to Torus to get all currency swaps
-func (c *Call) GetCurrencySwaps(ctx context.Context, status, from, to string, dateBetween *model.DateBetween, page *model.Page) (model.AllSwapsResponse, error) {
+// ListCurrencySwaps makes a request to Torus to get the currency swaps matching the filter
+func (c *Call) ListCurrencySwaps(ctx context.Context, filter model.SwapFilter, page *model.Page) (model.AllSwapsResponse, error) {
+	return c.listCurrencySwaps(ctx, "ListCurrencySwaps", filter, page)
+}
+
+// listCurrencySwaps backs ListCurrencySwaps and the deprecated GetCurrencySwaps, reported as operation
+func (c *Call) listCurrencySwaps(ctx context.Context, operation string, filter model.SwapFilter, page *model.Page) (model.AllSwapsResponse, error) {
 	var (
 		err      error
 		response model.AllSwapsResponse
@@ -33,27 +38,30 @@ func (c *Call) GetCurrencySwaps(ctx context.Context, status, from, to string, da
 		path     = currencySwapAPIVersion
 	)
 
-	if status != "" {
-		params["status"] = status
-	}
-	if to != "" {
-		params["to_currency"] = to
-	}
-	if from != "" {
-		params["from_currency"] = from
-	}
-	if dateBetween != nil {
-		helpers.FillParamsWithDateInterval(params, *dateBetween)
+	if err = helpers.FillParamsWithFilter(params, filter); err != nil {
+		return response, err
 	}
 	if page != nil {
 		helpers.FillParamsWithPage(params, *page)
 	}
 
-	err = c.makeRequest(ctx, path, http.MethodGet, nil, params, nil, nil, &response)
+	err = c.makeRequest(ctx, operation, path, http.MethodGet, nil, params, nil, nil, &response)
 
 	return response, err
 }
 
+// GetCurrencySwaps makes a request to Torus to get all currency swaps
+//
+// Deprecated: use ListCurrencySwaps, which takes a SwapFilter
+func (c *Call) GetCurrencySwaps(ctx context.Context, status, from, to string, dateBetween *model.DateBetween, page *model.Page) (model.AllSwapsResponse, error) {
+	return c.listCurrencySwaps(ctx, "GetCurrencySwaps", model.SwapFilter{
+		Status:       status,
+		FromCurrency: from,
+		ToCurrency:   to,
+		Dates:        dateBetween,
+	}, page)
+}
+
 // GetCurrencySwapByID makes a request to Torus to get currency swap by ID
 func (c *Call) GetCurrencySwapByID(ctx context.Context, currencySwapID string) (model.CurrencySwap, error) {
 	var (
@@ -62,7 +70,7 @@ func (c *Call) GetCurrencySwapByID(ctx context.Context, currencySwapID string) (
 		path     = fmt.Sprintf("%s/%s", currencySwapAPIVersion, currencySwapID)
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodGet, nil, nil, nil, nil, &response)
+	err = c.makeRequest(ctx, "GetCurrencySwapByID", path, http.MethodGet, nil, nil, nil, nil, &response)
 
 	return response, err
 }
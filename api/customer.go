@@ -17,10 +17,10 @@ func (c *Call) CreateCustomer(ctx context.Context, request model.CreateCustomerR
 		err       error
 		response  model.Customer
 		path      = customerAPIVersion
-		signature = helpers.GetSignatureFromReferenceAndPubKey(request.Reference, c.apiSecret)
+		reference = request.Reference
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodPost, &signature, nil, nil, request, &response)
+	err = c.makeRequest(ctx, "CreateCustomer", path, http.MethodPost, &reference, nil, nil, request, &response)
 
 	return response, err
 }
@@ -33,24 +33,58 @@ func (c *Call) UpdateCustomer(ctx context.Context, request model.UpdateCustomerR
 		path     = customerAPIVersion
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodPatch, nil, nil, nil, request, &response)
+	err = c.makeRequest(ctx, "UpdateCustomer", path, http.MethodPatch, nil, nil, nil, request, &response)
 
 	return response, err
 }
 
-// GetAllCustomers makes request to Torus to get all customers
-func (c *Call) GetAllCustomers(ctx context.Context) (model.AllCustomersResponse, error) {
+// ListCustomers makes request to Torus to get a page of the customers matching the filter
+func (c *Call) ListCustomers(ctx context.Context, filter model.CustomerFilter, page *model.Page) (model.AllCustomersResponse, error) {
+	return c.listCustomers(ctx, "ListCustomers", filter, page)
+}
+
+// listCustomers backs ListCustomers and the deprecated GetAllCustomers, reported as operation
+func (c *Call) listCustomers(ctx context.Context, operation string, filter model.CustomerFilter, page *model.Page) (model.AllCustomersResponse, error) {
 	var (
 		err      error
 		response model.AllCustomersResponse
+		params   = make(map[string]interface{})
 		path     = customerAPIVersion
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodGet, nil, nil, nil, nil, &response)
+	if err = helpers.FillParamsWithFilter(params, filter); err != nil {
+		return response, err
+	}
+	if page != nil {
+		helpers.FillParamsWithPage(params, *page)
+	}
+
+	err = c.makeRequest(ctx, operation, path, http.MethodGet, nil, params, nil, nil, &response)
 
 	return response, err
 }
 
+// GetAllCustomers makes request to Torus to get all customers
+//
+// Deprecated: use ListCustomers, which pages and filters the customers, or the Customers iterator
+func (c *Call) GetAllCustomers(ctx context.Context) (model.AllCustomersResponse, error) {
+	return c.listCustomers(ctx, "GetAllCustomers", model.CustomerFilter{}, nil)
+}
+
+// GetCustomerByReference looks up the customer created with the given reference, failing with model.ErrNotFound when there is none
+func (c *Call) GetCustomerByReference(ctx context.Context, reference string) (model.Customer, error) {
+	for customer, err := range c.Customers(ctx, model.CustomerFilter{Reference: reference}, ListOptions{}) {
+		if err != nil {
+			return model.Customer{}, err
+		}
+		// the reference filter of the API may match partially
+		if customer.Reference == reference {
+			return customer, nil
+		}
+	}
+	return model.Customer{}, fmt.Errorf("customer with reference %q: %w", reference, model.ErrNotFound)
+}
+
 // GetCustomerByID makes request to Torus to get customer by its ID
 func (c *Call) GetCustomerByID(ctx context.Context, customerID string) (model.Customer, error) {
 	var (
@@ -59,7 +93,7 @@ func (c *Call) GetCustomerByID(ctx context.Context, customerID string) (model.Cu
 		path     = fmt.Sprintf("%s/%s", customerAPIVersion, customerID)
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodGet, nil, nil, nil, nil, &response)
+	err = c.makeRequest(ctx, "GetCustomerByID", path, http.MethodGet, nil, nil, nil, nil, &response)
 
 	return response, err
 }
@@ -73,7 +107,7 @@ func (c *Call) GetCustomerBalance(ctx context.Context, customerID, yieldOffering
 		path     = fmt.Sprintf("%s/balance", customerAPIVersion)
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodGet, nil, params, nil, nil, &response)
+	err = c.makeRequest(ctx, "GetCustomerBalance", path, http.MethodGet, nil, params, nil, nil, &response)
 
 	return response, err
 }
@@ -86,7 +120,7 @@ func (c *Call) GetCustomerBalances(ctx context.Context, customerID string) (mode
 		path     = fmt.Sprintf("%s/balances/%s", customerAPIVersion, customerID)
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodGet, nil, nil, nil, nil, &response)
+	err = c.makeRequest(ctx, "GetCustomerBalances", path, http.MethodGet, nil, nil, nil, nil, &response)
 
 	return response, err
 }
@@ -98,7 +132,7 @@ func (c *Call) DeleteCustomer(ctx context.Context, customerID string) error {
 		path = fmt.Sprintf("%s/%s", customerAPIVersion, customerID)
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodDelete, nil, nil, nil, nil, nil)
+	err = c.makeRequest(ctx, "DeleteCustomer", path, http.MethodDelete, nil, nil, nil, nil, nil)
 
 	return err
 }
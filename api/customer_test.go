@@ -0,0 +1,72 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ovalfi/go-sdk/helpers"
+	"github.com/ovalfi/go-sdk/model"
+)
+
+func TestListCustomers(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/"+customerAPIVersion, r.URL.Path)
+		assert.Equal(t, "email=ada%40example.com&from=2024-01-01&number=2&search=ada&size=20", r.URL.Query().Encode())
+
+		body, err := json.Marshal(model.GenericResponse{Data: rawJSON(t, model.AllCustomersResponse{
+			Items: []model.Customer{{ID: "customer-1", Email: "ada@example.com"}},
+			Page:  model.PageInfo{Page: 2, Size: 20, TotalCount: 21},
+		})})
+		require.NoError(t, err)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(body)
+	}))
+	defer ts.Close()
+
+	response, err := newTestCall(ts.URL).ListCustomers(context.Background(), model.CustomerFilter{
+		Search: "ada",
+		Email:  "ada@example.com",
+		Dates:  &model.DateBetween{From: "2024-01-01"},
+	}, &model.Page{Number: helpers.GetPointerInt(2), Size: helpers.GetPointerInt(20)})
+	require.NoError(t, err)
+	assert.Equal(t, "customer-1", response.Items[0].ID)
+	assert.Equal(t, int64(21), response.Page.TotalCount)
+}
+
+func TestGetCustomerByReference(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/"+customerAPIVersion, r.URL.Path)
+
+		// the API matches references partially
+		var customers []model.Customer
+		switch r.URL.Query().Get("reference") {
+		case "ref-1":
+			customers = []model.Customer{{ID: "customer-10", Reference: "ref-10"}, {ID: "customer-1", Reference: "ref-1"}}
+		case "ref":
+			customers = []model.Customer{{ID: "customer-10", Reference: "ref-10"}}
+		}
+
+		body, err := json.Marshal(model.GenericResponse{Data: rawJSON(t, model.AllCustomersResponse{Items: customers})})
+		require.NoError(t, err)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(body)
+	}))
+	defer ts.Close()
+
+	call := newTestCall(ts.URL)
+
+	customer, err := call.GetCustomerByReference(context.Background(), "ref-1")
+	require.NoError(t, err)
+	assert.Equal(t, "customer-1", customer.ID)
+
+	_, err = call.GetCustomerByReference(context.Background(), "ref")
+	assert.True(t, errors.Is(err, model.ErrNotFound))
+}
@@ -0,0 +1,181 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm/dialects/postgres"
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ovalfi/go-sdk/model"
+)
+
+// fixtureSize is the number of items of the list fixtures, a large page of the list endpoints
+const fixtureSize = 500
+
+func allTransfersFixture() model.AllTransfersResponse {
+	createdAt := time.Date(2024, 9, 1, 10, 30, 0, 123456789, time.UTC)
+	response := model.AllTransfersResponse{
+		Page: model.PageInfo{Page: 1, Size: fixtureSize, HasNextPage: true, TotalCount: 10 * fixtureSize},
+	}
+	for i := 0; i < fixtureSize; i++ {
+		completedAt := createdAt.Add(time.Duration(i) * time.Minute)
+		reference := fmt.Sprintf("trf-%04d", i)
+		response.Items = append(response.Items, model.TerminalTransfer{
+			ID:               uuid.New(),
+			BusinessID:       uuid.New(),
+			Type:             "terminal",
+			Amount:           model.Money{Currency: "USD", Amount: float64(i) + 0.5},
+			Deposit:          model.Money{Currency: "USD", Amount: float64(i)},
+			Transfer:         model.Money{Currency: "NGN", Amount: float64(i) * 1500},
+			SourceCurrency:   "USD",
+			Fee:              model.Money{Currency: "USD", Amount: 0.5},
+			FeePercentage:    1.5,
+			Status:           "completed",
+			ComplianceStatus: "approved",
+			BeneficiaryDetails: postgres.Jsonb{
+				RawMessage: json.RawMessage(fmt.Sprintf(`{"account_number":"%010d","bank_code":"058"}`, i)),
+			},
+			Reason:      "invoice",
+			Reference:   &reference,
+			CompletedAt: &completedAt,
+			CreatedAt:   createdAt,
+		})
+	}
+	return response
+}
+
+func allPayoutsFixture() model.AllPayoutsResponse {
+	createdAt := time.Date(2024, 9, 1, 10, 30, 0, 0, time.UTC)
+	response := model.AllPayoutsResponse{
+		Page: model.PageInfo{Page: 1, Size: fixtureSize, TotalCount: fixtureSize},
+	}
+	for i := 0; i < fixtureSize; i++ {
+		response.Items = append(response.Items, model.PayoutDetails{
+			ID:          uuid.New(),
+			BusinessID:  uuid.New(),
+			Status:      "pending",
+			Count:       i,
+			Currency:    "NGN",
+			TotalAmount: i * 1000,
+			Fee:         model.Money{Currency: "NGN", Amount: 50},
+			Remarks:     "salaries",
+			CreatedAt:   createdAt,
+			UpdatedAt:   createdAt.Add(time.Hour),
+		})
+	}
+	return response
+}
+
+// envelope returns the body of a successful API response carrying data
+func envelope(tb testing.TB, data interface{}) []byte {
+	tb.Helper()
+	body, err := json.Marshal(map[string]interface{}{"status": 200, "data": data})
+	require.NoError(tb, err)
+	return body
+}
+
+// decodeJSON is the current decoding path: the data is kept raw and unmarshalled straight into v
+func decodeJSON(body []byte, v interface{}) error {
+	var response model.GenericResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return err
+	}
+	return decode(response.Data, v)
+}
+
+// decodeMapstructure is the former decoding path: the data is unmarshalled into interface{}, then converted with mapstructure
+func decodeMapstructure(body []byte, v interface{}) error {
+	var response struct {
+		Data interface{} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return err
+	}
+
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		Result:           v,
+		TagName:          "json",
+		WeaklyTypedInput: true,
+		DecodeHook: mapstructure.ComposeDecodeHookFunc(
+			func(f, t reflect.Type, data interface{}) (interface{}, error) {
+				if str, ok := data.(string); ok && t == reflect.TypeOf(time.Time{}) {
+					return time.Parse(time.RFC3339Nano, str)
+				}
+				return data, nil
+			},
+			func(f, t reflect.Type, data interface{}) (interface{}, error) {
+				if str, ok := data.(string); ok && t == reflect.TypeOf(uuid.UUID{}) {
+					return uuid.Parse(str)
+				}
+				return data, nil
+			},
+		),
+	})
+	if err != nil {
+		return err
+	}
+	return decoder.Decode(response.Data)
+}
+
+func TestDecodeFixtures(t *testing.T) {
+	tests := map[string]struct {
+		fixture   interface{}
+		newResult func() interface{}
+	}{
+		"all transfers": {
+			fixture:   allTransfersFixture(),
+			newResult: func() interface{} { return &model.AllTransfersResponse{} },
+		},
+		"all payouts": {
+			fixture:   allPayoutsFixture(),
+			newResult: func() interface{} { return &model.AllPayoutsResponse{} },
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			body := envelope(t, tt.fixture)
+
+			decoded := tt.newResult()
+			require.NoError(t, decodeJSON(body, decoded))
+			assert.Equal(t, tt.fixture, reflect.ValueOf(decoded).Elem().Interface())
+
+			// the former path must succeed for the benchmarks to be meaningful, although it drops the JSONB fields
+			require.NoError(t, decodeMapstructure(body, tt.newResult()))
+		})
+	}
+}
+
+func BenchmarkDecodeAllTransfers(b *testing.B) {
+	body := envelope(b, allTransfersFixture())
+	benchmarkDecode(b, body, func() interface{} { return &model.AllTransfersResponse{} })
+}
+
+func BenchmarkDecodeAllPayouts(b *testing.B) {
+	body := envelope(b, allPayoutsFixture())
+	benchmarkDecode(b, body, func() interface{} { return &model.AllPayoutsResponse{} })
+}
+
+func benchmarkDecode(b *testing.B, body []byte, newResult func() interface{}) {
+	decoders := map[string]func([]byte, interface{}) error{
+		"json":         decodeJSON,
+		"mapstructure": decodeMapstructure,
+	}
+	for name, decoder := range decoders {
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(body)))
+			for i := 0; i < b.N; i++ {
+				if err := decoder(body, newResult()); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -7,7 +7,6 @@ import (
 	"net/http"
 	"strconv"
 
-	"github.com/ovalfi/go-sdk/helpers"
 	"github.com/ovalfi/go-sdk/model"
 )
 
@@ -17,10 +16,10 @@ func (c *Call) InitiateDeposit(ctx context.Context, request model.InitiateDeposi
 		err       error
 		response  model.Deposit
 		path      = "v1/deposit"
-		signature = helpers.GetSignatureFromReferenceAndPubKey(request.Reference, c.apiSecret)
+		reference = request.Reference
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodPost, &signature, nil, nil, request, &response)
+	err = c.makeRequest(ctx, "InitiateDeposit", path, http.MethodPost, &reference, nil, nil, request, &response)
 
 	return response, err
 }
@@ -38,7 +37,7 @@ func (c *Call) GetAllDeposits(ctx context.Context, settled *bool) (model.Deposit
 		params["settled"] = strconv.FormatBool(*settled)
 	}
 
-	err = c.makeRequest(ctx, path, http.MethodGet, nil, params, nil, nil, &response)
+	err = c.makeRequest(ctx, "GetAllDeposits", path, http.MethodGet, nil, params, nil, nil, &response)
 
 	return response, err
 }
@@ -80,7 +79,7 @@ func (c *Call) GetDepositByIDOrReference(ctx context.Context, id, reference *str
 
 	fullPath = basePath + query
 
-	err = c.makeRequest(ctx, fullPath, http.MethodGet, nil, nil, nil, nil, &response)
+	err = c.makeRequest(ctx, "GetDepositByIDOrReference", fullPath, http.MethodGet, nil, nil, nil, nil, &response)
 
 	return response, err
 }
@@ -91,10 +90,10 @@ func (c *Call) InternalFundsTransfer(ctx context.Context, request model.FundTran
 		err       error
 		response  model.Deposit
 		path      = "v1/transfer-funds"
-		signature = helpers.GetSignatureFromReferenceAndPubKey(request.Reference, c.apiSecret)
+		reference = request.Reference
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodPost, &signature, nil, nil, request, &response)
+	err = c.makeRequest(ctx, "InternalFundsTransfer", path, http.MethodPost, &reference, nil, nil, request, &response)
 
 	return response, err
 }
@@ -105,10 +104,10 @@ func (c *Call) IntraTransfer(ctx context.Context, request model.IntraTransferReq
 		err       error
 		response  model.IntraTransferResponse
 		path      = "v1/intra-transfer"
-		signature = helpers.GetSignatureFromReferenceAndPubKey(request.Reference, c.apiSecret)
+		reference = request.Reference
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodPost, &signature, nil, nil, request, &response)
+	err = c.makeRequest(ctx, "IntraTransfer", path, http.MethodPost, &reference, nil, nil, request, &response)
 
 	return response, err
 }
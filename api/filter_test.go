@@ -0,0 +1,99 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ovalfi/go-sdk/helpers"
+	"github.com/ovalfi/go-sdk/model"
+)
+
+func TestListFiltersMatchDeprecatedSignatures(t *testing.T) {
+	var queries []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Path+"?"+r.URL.Query().Encode())
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	defer ts.Close()
+
+	call := newTestCall(ts.URL)
+	ctx := context.Background()
+	dates := &model.DateBetween{From: "2024-01-01", To: "2024-01-31"}
+	page := &model.Page{Number: helpers.GetPointerInt(2), Size: helpers.GetPointerInt(50)}
+
+	tests := map[string]struct {
+		deprecated func() error
+		list       func() error
+		expected   string
+	}{
+		"transactions": {
+			deprecated: func() error {
+				_, err := call.GetTransactions(ctx, "customer-1", "", "completed", "", "", helpers.GetPointerFloat64(0), dates, page)
+				return err
+			},
+			list: func() error {
+				_, err := call.ListTransactions(ctx, model.TransactionFilter{CustomerID: "customer-1", Status: "completed", Amount: helpers.GetPointerFloat64(0), Dates: dates}, page)
+				return err
+			},
+			expected: "/v1/transaction?amount=0&customer_id=customer-1&from=2024-01-01&number=2&size=50&status=completed&to=2024-01-31",
+		},
+		"terminal transfers": {
+			deprecated: func() error {
+				_, err := call.GetTerminalTransfers(ctx, "", "USD", "NGN", nil, nil)
+				return err
+			},
+			list: func() error {
+				_, err := call.ListTerminalTransfers(ctx, model.TerminalTransferFilter{SourceCurrency: "USD", DestinationCurrency: "NGN"}, nil)
+				return err
+			},
+			expected: "/" + transferAPIVersion + "?destination_currency=NGN&source_currency=USD",
+		},
+		"payouts": {
+			deprecated: func() error {
+				_, err := call.GetAllPayouts(ctx, "pending", "acme", model.DateBetween{}, model.Page{})
+				return err
+			},
+			list: func() error {
+				_, err := call.ListPayouts(ctx, model.PayoutFilter{Status: "pending", Search: "acme"}, nil)
+				return err
+			},
+			expected: "/" + payoutAPIVersion + "?search=acme&status=pending",
+		},
+		"currency swaps": {
+			deprecated: func() error {
+				_, err := call.GetCurrencySwaps(ctx, "", "USD", "EUR", dates, nil)
+				return err
+			},
+			list: func() error {
+				_, err := call.ListCurrencySwaps(ctx, model.SwapFilter{FromCurrency: "USD", ToCurrency: "EUR", Dates: dates}, nil)
+				return err
+			},
+			expected: "/" + currencySwapAPIVersion + "?from=2024-01-01&from_currency=USD&to=2024-01-31&to_currency=EUR",
+		},
+		"payment cards": {
+			deprecated: func() error {
+				_, err := call.GetCustomerPaymentCards(ctx, "customer-1", helpers.GetPointerString("active"), nil, nil, page)
+				return err
+			},
+			list: func() error {
+				_, err := call.ListCustomerPaymentCards(ctx, "customer-1", model.PaymentCardFilter{Status: "active"}, page)
+				return err
+			},
+			expected: "/v1/payments/cards/customer-1?number=2&size=50&status=active",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			queries = nil
+			require.NoError(t, tt.deprecated())
+			require.NoError(t, tt.list())
+			assert.Equal(t, []string{tt.expected, tt.expected}, queries)
+		})
+	}
+}
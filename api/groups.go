@@ -0,0 +1,63 @@
+package api
+
+// EndpointGroup groups RemoteCalls methods that share limits and policies, such as rate limits or timeouts
+type EndpointGroup string
+
+const (
+	// GroupPayments covers the money-moving and ledger endpoints: transfers, payouts, deposits, withdrawals, swaps, cards...
+	GroupPayments EndpointGroup = "payments"
+	// GroupBills covers the bill payment endpoints
+	GroupBills EndpointGroup = "bills"
+	// GroupKYC covers the KYC and compliance endpoints
+	GroupKYC EndpointGroup = "kyc"
+	// GroupReferenceData covers the lookups of banks, rates, billers, assets and configuration
+	GroupReferenceData EndpointGroup = "reference_data"
+	// GroupCustomers covers the customer management endpoints
+	GroupCustomers EndpointGroup = "customers"
+)
+
+// operationGroups maps the RemoteCalls methods to their endpoint group. Methods that are not listed belong to GroupPayments
+var operationGroups = map[string]EndpointGroup{
+	"CreateCustomer":      GroupCustomers,
+	"UpdateCustomer":      GroupCustomers,
+	"GetAllCustomers":     GroupCustomers,
+	"ListCustomers":       GroupCustomers,
+	"GetCustomerByID":     GroupCustomers,
+	"GetCustomerBalance":  GroupCustomers,
+	"GetCustomerBalances": GroupCustomers,
+	"DeleteCustomer":      GroupCustomers,
+
+	"GetBillerProducts":         GroupBills,
+	"ValidateBillerCustomer":    GroupBills,
+	"PayBill":                   GroupBills,
+	"GetBillPaymentTransaction": GroupBills,
+
+	"GetKYCByCustomerID":              GroupKYC,
+	"SubmitCustomerKYCDocument":       GroupKYC,
+	"SubmitCustomerKYCDocumentUpload": GroupKYC,
+	"VerifyCustomerKYC":               GroupKYC,
+	"GetVerifyBiometricsLink":         GroupKYC,
+	"GetVerifyCustomerKYC":            GroupKYC,
+	"SubmitSTR":                       GroupKYC,
+
+	"GetBanks":                  GroupReferenceData,
+	"GetSupportedBanks":         GroupReferenceData,
+	"GetCompetitorsRates":       GroupReferenceData,
+	"GetExchangeRates":          GroupReferenceData,
+	"ResolveBankAccount":        GroupReferenceData,
+	"ValidatePhoneNumber":       GroupReferenceData,
+	"GetTermsOfService":         GroupReferenceData,
+	"GetPayoutConfig":           GroupReferenceData,
+	"GetPayoutDocumentTemplate": GroupReferenceData,
+	"GetSupportedAssets":        GroupReferenceData,
+	"GetBillerCategories":       GroupReferenceData,
+	"GetBillers":                GroupReferenceData,
+}
+
+// groupOf returns the endpoint group of a RemoteCalls method
+func groupOf(operation string) EndpointGroup {
+	if group, ok := operationGroups[operation]; ok {
+		return group
+	}
+	return GroupPayments
+}
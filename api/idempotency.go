@@ -0,0 +1,98 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/ovalfi/go-sdk/model"
+)
+
+// idempotencyNamespace is the namespace idempotency keys are derived in, so that the same reference always yields the same key
+var idempotencyNamespace = uuid.NewSHA1(uuid.NameSpaceURL, []byte("https://ovalfi.com/idempotency-key"))
+
+// WithIdempotencyKey returns a context that sets the idempotency key sent with the calls made with it.
+// Reuse the same key when retrying a call that timed out so that the API processes it only once
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, model.IdempotencyKeyContextKey, key)
+}
+
+// referenceFields are the JSON names of the request body fields idempotency keys are derived from, by priority
+var referenceFields = []string{"reference", "transaction_reference"}
+
+// idempotencyKey returns the idempotency key to send for a request. The key carried by the context wins, then a key derived
+// from the request reference, then a random key that stays the same across the retries of the call
+func idempotencyKey(ctx context.Context, method, path string, requestBody interface{}) string {
+	if method == http.MethodGet || method == http.MethodHead {
+		return ""
+	}
+
+	if key := contextIdempotencyKey(ctx); key != "" {
+		return key
+	}
+
+	if reference := referenceOf(requestBody); reference != "" {
+		return uuid.NewSHA1(idempotencyNamespace, []byte(strings.Join([]string{method, path, reference}, " "))).String()
+	}
+
+	return uuid.NewString()
+}
+
+// hasStableIdempotencyKey reports whether the idempotency key of a request is the same when the caller makes the call
+// again, as it comes from the context or the request reference. Random keys do not let the API recognise a retry
+func hasStableIdempotencyKey(ctx context.Context, request *Request) bool {
+	return contextIdempotencyKey(ctx) != "" || referenceOf(request.Body) != ""
+}
+
+// contextIdempotencyKey returns the idempotency key carried by the context, if any
+func contextIdempotencyKey(ctx context.Context) string {
+	key, _ := ctx.Value(model.IdempotencyKeyContextKey).(string)
+	return key
+}
+
+// referenceOf returns the value of the first non-empty reference field of a request body, if any
+func referenceOf(requestBody interface{}) string {
+	v := reflect.ValueOf(requestBody)
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return ""
+	}
+
+	for _, name := range referenceFields {
+		if reference := stringField(v, name); reference != "" {
+			return reference
+		}
+	}
+	return ""
+}
+
+// stringField returns the value of the string field with the given JSON name in a struct, if any
+func stringField(v reflect.Value, name string) string {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		tag, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if tag != name {
+			continue
+		}
+
+		field := v.Field(i)
+		if field.Kind() == reflect.Ptr {
+			if field.IsNil() {
+				return ""
+			}
+			field = field.Elem()
+		}
+		if field.Kind() == reflect.String {
+			return field.String()
+		}
+	}
+	return ""
+}
@@ -0,0 +1,138 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ovalfi/go-sdk/model"
+)
+
+// headerRecorder records the idempotency key of every request it receives and fails the first `failures` of them
+type headerRecorder struct {
+	mu       sync.Mutex
+	keys     []string
+	failures int
+}
+
+func (h *headerRecorder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mu.Lock()
+	h.keys = append(h.keys, r.Header.Get(model.IdempotencyKeyHeaderKey))
+	fail := len(h.keys) <= h.failures
+	h.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	if fail {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte(`{"error":{"details":"unavailable"}}`))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(`{"data":{}}`))
+}
+
+func TestIdempotencyKeyFromReference(t *testing.T) {
+	recorder := &headerRecorder{}
+	ts := httptest.NewServer(recorder)
+	defer ts.Close()
+
+	call := newTestCall(ts.URL)
+	request := model.InitiateDepositRequest{Reference: "dep-001"}
+
+	_, err := call.InitiateDeposit(context.Background(), request)
+	assert.NoError(t, err)
+	_, err = call.InitiateDeposit(context.Background(), request)
+	assert.NoError(t, err)
+	_, err = call.InitiateDeposit(context.Background(), model.InitiateDepositRequest{Reference: "dep-002"})
+	assert.NoError(t, err)
+
+	assert.Len(t, recorder.keys, 3)
+	assert.NotEmpty(t, recorder.keys[0])
+	assert.Equal(t, recorder.keys[0], recorder.keys[1])
+	assert.NotEqual(t, recorder.keys[0], recorder.keys[2])
+}
+
+func TestIdempotencyKeyOverrideAndRetry(t *testing.T) {
+	recorder := &headerRecorder{failures: 1}
+	ts := httptest.NewServer(recorder)
+	defer ts.Close()
+
+	call := newTestCall(ts.URL)
+	call.retryPolicy = RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond}
+
+	ctx := WithIdempotencyKey(context.Background(), "bill-key-1")
+	_, err := call.PayBill(ctx, model.PayBillRequest{Code: "mtn-100", CustomerID: "08012345678", Amount: 100})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"bill-key-1", "bill-key-1"}, recorder.keys)
+}
+
+func TestIdempotencyKeyFromTransactionReference(t *testing.T) {
+	recorder := &headerRecorder{failures: 1}
+	ts := httptest.NewServer(recorder)
+	defer ts.Close()
+
+	call := newTestCall(ts.URL)
+	call.retryPolicy = RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond}
+
+	reference := "payout-001"
+	_, err := call.InitiateDirectBulkPayout(context.Background(), model.InitiateBulkPayoutRequest{Currency: "USD", TransactionReference: &reference})
+	assert.NoError(t, err)
+
+	assert.Len(t, recorder.keys, 2)
+	assert.NotEmpty(t, recorder.keys[0])
+	assert.Equal(t, recorder.keys[0], recorder.keys[1])
+}
+
+func TestIdempotencyKeyWithoutReferenceIsNotRetried(t *testing.T) {
+	recorder := &headerRecorder{failures: 1}
+	ts := httptest.NewServer(recorder)
+	defer ts.Close()
+
+	call := newTestCall(ts.URL)
+	call.retryPolicy = RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond}
+
+	_, err := call.InitiateCurrencySwap(context.Background(), model.InitiateCurrencySwapRequest{FromCurrency: "USD", ToCurrency: "NGN", Amount: 10})
+	assert.Error(t, err)
+	_, err = call.PayBill(context.Background(), model.PayBillRequest{Code: "mtn-100", CustomerID: "08012345678", Amount: 100})
+	assert.NoError(t, err)
+
+	// each call sends a key of its own, once
+	assert.Len(t, recorder.keys, 2)
+	assert.NotEmpty(t, recorder.keys[0])
+	assert.NotEmpty(t, recorder.keys[1])
+	assert.NotEqual(t, recorder.keys[0], recorder.keys[1])
+}
+
+func TestIdempotencyKeyKeptAcrossRetries(t *testing.T) {
+	recorder := &headerRecorder{failures: 1}
+	ts := httptest.NewServer(recorder)
+	defer ts.Close()
+
+	call := newTestCall(ts.URL)
+	call.retryPolicy = RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond}
+
+	err := call.DeleteCustomer(context.Background(), "customer-1")
+	assert.NoError(t, err)
+
+	assert.Len(t, recorder.keys, 2)
+	assert.NotEmpty(t, recorder.keys[0])
+	assert.Equal(t, recorder.keys[0], recorder.keys[1])
+}
+
+func TestIdempotencyKeyNotSentOnReads(t *testing.T) {
+	recorder := &headerRecorder{}
+	ts := httptest.NewServer(recorder)
+	defer ts.Close()
+
+	call := newTestCall(ts.URL)
+	_, err := call.GetCustomerByID(WithIdempotencyKey(context.Background(), "ignored"), "customer-id")
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{""}, recorder.keys)
+}
@@ -0,0 +1,159 @@
+package api
+
+import (
+	"context"
+	"iter"
+
+	"github.com/ovalfi/go-sdk/helpers"
+	"github.com/ovalfi/go-sdk/model"
+)
+
+// The sequences returned by the iterators are plain iter.Seq2 aliases, named so that mockgen can read RemoteCalls
+type (
+	// CustomerSeq yields customers
+	CustomerSeq = iter.Seq2[model.Customer, error]
+	// TerminalTransferSeq yields terminal transfers
+	TerminalTransferSeq = iter.Seq2[model.TerminalTransfer, error]
+	// TransactionSeq yields transactions
+	TransactionSeq = iter.Seq2[*model.Transaction, error]
+	// PayoutSeq yields payouts
+	PayoutSeq = iter.Seq2[model.PayoutDetails, error]
+	// CurrencySwapSeq yields currency swaps
+	CurrencySwapSeq = iter.Seq2[model.CurrencySwap, error]
+	// BeneficiarySeq yields transfer beneficiaries
+	BeneficiarySeq = iter.Seq2[model.TransferBeneficiary, error]
+	// PaymentCardSeq yields payment cards
+	PaymentCardSeq = iter.Seq2[model.PaymentCard, error]
+	// BillerProductSeq yields biller products
+	BillerProductSeq = iter.Seq2[model.BillerProduct, error]
+)
+
+// ListOptions tunes how an iterator walks the pages of a list endpoint
+type ListOptions struct {
+	// Page is the first page fetched, along with the page size and sort order. Defaults to the first page
+	Page model.Page
+	// MaxItems stops the iteration after that many items, 0 means no limit
+	MaxItems int
+}
+
+// paginate yields the items of every page returned by fetch, starting from the page of the options and stopping
+// after the last page, the first error, MaxItems items or when the context is done. Pages are fetched lazily,
+// so breaking out of the loop fetches no more pages
+func paginate[T any](ctx context.Context, opts ListOptions, fetch func(ctx context.Context, page *model.Page) ([]T, model.PageInfo, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+
+		page := opts.Page
+		number := 1
+		if page.Number != nil {
+			number = *page.Number
+		}
+
+		yielded := 0
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			page.Number = helpers.GetPointerInt(number)
+			items, info, err := fetch(ctx, &page)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range items {
+				if opts.MaxItems > 0 && yielded >= opts.MaxItems {
+					return
+				}
+				if !yield(item, nil) {
+					return
+				}
+				yielded++
+			}
+
+			if !info.HasNextPage || len(items) == 0 || (opts.MaxItems > 0 && yielded >= opts.MaxItems) {
+				return
+			}
+			number++
+		}
+	}
+}
+
+// Customers iterates over the customers matching the filter, fetching the pages as they are consumed
+func (c *Call) Customers(ctx context.Context, filter model.CustomerFilter, opts ListOptions) CustomerSeq {
+	return paginate(ctx, opts, func(ctx context.Context, page *model.Page) ([]model.Customer, model.PageInfo, error) {
+		response, err := c.ListCustomers(ctx, filter, page)
+		return response.Items, response.Page, err
+	})
+}
+
+// TerminalTransfers iterates over the terminal transfers matching the filter, fetching the pages as they are consumed
+//
+//	for transfer, err := range client.TerminalTransfers(ctx, model.TerminalTransferFilter{Status: "completed"}, api.ListOptions{}) {
+//		if err != nil {
+//			return err
+//		}
+//		...
+//	}
+func (c *Call) TerminalTransfers(ctx context.Context, filter model.TerminalTransferFilter, opts ListOptions) TerminalTransferSeq {
+	return paginate(ctx, opts, func(ctx context.Context, page *model.Page) ([]model.TerminalTransfer, model.PageInfo, error) {
+		response, err := c.ListTerminalTransfers(ctx, filter, page)
+		return response.Items, response.Page, err
+	})
+}
+
+// Transactions iterates over the transactions matching the filter, fetching the pages as they are consumed
+func (c *Call) Transactions(ctx context.Context, filter model.TransactionFilter, opts ListOptions) TransactionSeq {
+	return paginate(ctx, opts, func(ctx context.Context, page *model.Page) ([]*model.Transaction, model.PageInfo, error) {
+		response, err := c.ListTransactions(ctx, filter, page)
+		return response.Items.Transactions, response.Page, err
+	})
+}
+
+// Payouts iterates over the payouts matching the filter, fetching the pages as they are consumed
+func (c *Call) Payouts(ctx context.Context, filter model.PayoutFilter, opts ListOptions) PayoutSeq {
+	return paginate(ctx, opts, func(ctx context.Context, page *model.Page) ([]model.PayoutDetails, model.PageInfo, error) {
+		response, err := c.ListPayouts(ctx, filter, page)
+		return response.Items, response.Page, err
+	})
+}
+
+// CurrencySwaps iterates over the currency swaps matching the filter, fetching the pages as they are consumed
+func (c *Call) CurrencySwaps(ctx context.Context, filter model.SwapFilter, opts ListOptions) CurrencySwapSeq {
+	return paginate(ctx, opts, func(ctx context.Context, page *model.Page) ([]model.CurrencySwap, model.PageInfo, error) {
+		response, err := c.ListCurrencySwaps(ctx, filter, page)
+		return response.Items, response.Page, err
+	})
+}
+
+// Beneficiaries iterates over the beneficiaries of a destination currency, or of every currency when empty
+func (c *Call) Beneficiaries(ctx context.Context, currency string, opts ListOptions) BeneficiarySeq {
+	return paginate(ctx, opts, func(ctx context.Context, page *model.Page) ([]model.TransferBeneficiary, model.PageInfo, error) {
+		response, err := c.GetBeneficiaries(ctx, currency, page)
+		if response.Items == nil {
+			return nil, response.Page, err
+		}
+		return *response.Items, response.Page, err
+	})
+}
+
+// CustomerPaymentCards iterates over the payment cards of a customer matching the filter
+func (c *Call) CustomerPaymentCards(ctx context.Context, customerID string, filter model.PaymentCardFilter, opts ListOptions) PaymentCardSeq {
+	return paginate(ctx, opts, func(ctx context.Context, page *model.Page) ([]model.PaymentCard, model.PageInfo, error) {
+		response, err := c.ListCustomerPaymentCards(ctx, customerID, filter, page)
+		if response.Items == nil {
+			return nil, response.Page, err
+		}
+		return *response.Items, response.Page, err
+	})
+}
+
+// BillerProducts iterates over the products offered by a biller in a country
+func (c *Call) BillerProducts(ctx context.Context, category, biller, country string, billingType *string, opts ListOptions) BillerProductSeq {
+	return paginate(ctx, opts, func(ctx context.Context, page *model.Page) ([]model.BillerProduct, model.PageInfo, error) {
+		response, err := c.GetBillerProducts(ctx, category, biller, country, billingType, page)
+		return response.Items, response.Page, err
+	})
+}
@@ -0,0 +1,142 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ovalfi/go-sdk/helpers"
+	"github.com/ovalfi/go-sdk/model"
+)
+
+// newPagedServer serves pages of two swaps out of five, failing the page given by failPage
+func newPagedServer(t *testing.T, requests *atomic.Int32, failPage int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		assert.Equal(t, "/"+currencySwapAPIVersion, r.URL.Path)
+		assert.Equal(t, "completed", r.URL.Query().Get("status"))
+		assert.Equal(t, "2", r.URL.Query().Get("size"))
+
+		number, err := strconv.Atoi(r.URL.Query().Get("number"))
+		require.NoError(t, err)
+
+		w.Header().Set("Content-Type", "application/json")
+		if number == failPage {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":{"id":"bad_request","details":"bad request"}}`))
+			return
+		}
+
+		var response model.AllSwapsResponse
+		for i := (number-1)*2 + 1; i <= number*2 && i <= 5; i++ {
+			response.Items = append(response.Items, model.CurrencySwap{ExchangeRate: float64(i)})
+		}
+		response.Page = model.PageInfo{Page: int64(number), Size: 2, HasNextPage: number < 3, TotalCount: 5}
+
+		body, err := json.Marshal(model.GenericResponse{Data: rawJSON(t, response)})
+		require.NoError(t, err)
+		_, _ = w.Write(body)
+	}))
+}
+
+func collectSwaps(ctx context.Context, t *testing.T, call *Call, opts ListOptions) ([]float64, error) {
+	t.Helper()
+	var rates []float64
+	for swap, err := range call.CurrencySwaps(ctx, model.SwapFilter{Status: "completed"}, opts) {
+		if err != nil {
+			return rates, err
+		}
+		rates = append(rates, swap.ExchangeRate)
+	}
+	return rates, nil
+}
+
+func TestIteratorWalksEveryPage(t *testing.T) {
+	var requests atomic.Int32
+	ts := newPagedServer(t, &requests, 0)
+	defer ts.Close()
+
+	rates, err := collectSwaps(context.Background(), t, newTestCall(ts.URL), ListOptions{Page: model.Page{Size: helpers.GetPointerInt(2)}})
+	require.NoError(t, err)
+	assert.Equal(t, []float64{1, 2, 3, 4, 5}, rates)
+	assert.Equal(t, int32(3), requests.Load())
+}
+
+func TestIteratorStartsFromPage(t *testing.T) {
+	var requests atomic.Int32
+	ts := newPagedServer(t, &requests, 0)
+	defer ts.Close()
+
+	rates, err := collectSwaps(context.Background(), t, newTestCall(ts.URL), ListOptions{Page: model.Page{Number: helpers.GetPointerInt(2), Size: helpers.GetPointerInt(2)}})
+	require.NoError(t, err)
+	assert.Equal(t, []float64{3, 4, 5}, rates)
+	assert.Equal(t, int32(2), requests.Load())
+}
+
+func TestIteratorMaxItems(t *testing.T) {
+	var requests atomic.Int32
+	ts := newPagedServer(t, &requests, 0)
+	defer ts.Close()
+
+	rates, err := collectSwaps(context.Background(), t, newTestCall(ts.URL), ListOptions{Page: model.Page{Size: helpers.GetPointerInt(2)}, MaxItems: 4})
+	require.NoError(t, err)
+	assert.Equal(t, []float64{1, 2, 3, 4}, rates)
+	assert.Equal(t, int32(2), requests.Load(), "no page is fetched once the cap is reached")
+}
+
+func TestIteratorBreak(t *testing.T) {
+	var requests atomic.Int32
+	ts := newPagedServer(t, &requests, 0)
+	defer ts.Close()
+
+	for swap, err := range newTestCall(ts.URL).CurrencySwaps(context.Background(), model.SwapFilter{Status: "completed"}, ListOptions{Page: model.Page{Size: helpers.GetPointerInt(2)}}) {
+		require.NoError(t, err)
+		if swap.ExchangeRate == 1 {
+			break
+		}
+	}
+	assert.Equal(t, int32(1), requests.Load())
+}
+
+func TestIteratorError(t *testing.T) {
+	var requests atomic.Int32
+	ts := newPagedServer(t, &requests, 2)
+	defer ts.Close()
+
+	rates, err := collectSwaps(context.Background(), t, newTestCall(ts.URL), ListOptions{Page: model.Page{Size: helpers.GetPointerInt(2)}})
+	var apiErr *model.APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, []float64{1, 2}, rates)
+}
+
+func TestIteratorContextCancelled(t *testing.T) {
+	var requests atomic.Int32
+	ts := newPagedServer(t, &requests, 0)
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var rates []float64
+	var iterErr error
+	for swap, err := range newTestCall(ts.URL).CurrencySwaps(ctx, model.SwapFilter{Status: "completed"}, ListOptions{Page: model.Page{Size: helpers.GetPointerInt(2)}}) {
+		if err != nil {
+			iterErr = err
+			break
+		}
+		rates = append(rates, swap.ExchangeRate)
+		cancel()
+	}
+
+	assert.True(t, errors.Is(iterErr, context.Canceled))
+	assert.Equal(t, []float64{1, 2}, rates)
+	assert.Equal(t, int32(1), requests.Load())
+}
@@ -17,12 +17,14 @@ func (c *Call) GetKYCByCustomerID(ctx context.Context, customerID string) (model
 		path     = fmt.Sprintf("%s/%s", kycAPIVersion, customerID)
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodGet, nil, nil, nil, nil, &response)
+	err = c.makeRequest(ctx, "GetKYCByCustomerID", path, http.MethodGet, nil, nil, nil, nil, &response)
 
 	return response, err
 }
 
 // SubmitCustomerKYCDocument make request to submit a KYC document for a customer
+//
+// Deprecated: use SubmitCustomerKYCDocumentUpload, which streams documents from any io.Reader
 func (c *Call) SubmitCustomerKYCDocument(
 	ctx context.Context,
 	customerID string,
@@ -30,6 +32,46 @@ func (c *Call) SubmitCustomerKYCDocument(
 	backDocument *os.File, // nil only if there is a front side
 	documentType string,
 	country string,
+) (model.KYCResponse, error) {
+	front, err := model.NewFileUpload(frontDocument)
+	if err != nil {
+		return model.KYCResponse{}, err
+	}
+
+	var back *model.Upload
+	if backDocument != nil {
+		upload, err := model.NewFileUpload(backDocument)
+		if err != nil {
+			return model.KYCResponse{}, err
+		}
+		back = &upload
+	}
+
+	return c.submitCustomerKYCDocumentUpload(ctx, "SubmitCustomerKYCDocument", customerID, front, back, documentType, country)
+}
+
+// SubmitCustomerKYCDocumentUpload make request to submit a KYC document for a customer, streaming the document sides
+func (c *Call) SubmitCustomerKYCDocumentUpload(
+	ctx context.Context,
+	customerID string,
+	frontDocument model.Upload,
+	backDocument *model.Upload, // nil only if there is a front side
+	documentType string,
+	country string,
+) (model.KYCResponse, error) {
+	return c.submitCustomerKYCDocumentUpload(ctx, "SubmitCustomerKYCDocumentUpload", customerID, frontDocument, backDocument, documentType, country)
+}
+
+// submitCustomerKYCDocumentUpload backs SubmitCustomerKYCDocumentUpload and the deprecated SubmitCustomerKYCDocument,
+// reported as operation
+func (c *Call) submitCustomerKYCDocumentUpload(
+	ctx context.Context,
+	operation string,
+	customerID string,
+	frontDocument model.Upload,
+	backDocument *model.Upload, // nil only if there is a front side
+	documentType string,
+	country string,
 ) (model.KYCResponse, error) {
 	var (
 		response model.KYCResponse
@@ -37,12 +79,23 @@ func (c *Call) SubmitCustomerKYCDocument(
 		path     = fmt.Sprintf("%s/%s/document", kycAPIVersion, customerID)
 	)
 
+	// the request ID is set here so that upload failures carry it
+	ctx = withRequestID(ctx)
+
 	// required front side
-	formData["document_front_side"] = frontDocument
+	front, err := c.prepareUpload(ctx, "document_front_side", frontDocument)
+	if err != nil {
+		return response, err
+	}
+	formData["document_front_side"] = front
 
 	// optional back side
 	if backDocument != nil {
-		formData["document_back_side"] = backDocument
+		back, err := c.prepareUpload(ctx, "document_back_side", *backDocument)
+		if err != nil {
+			return response, err
+		}
+		formData["document_back_side"] = back
 	}
 
 	// the rest text fields
@@ -50,8 +103,9 @@ func (c *Call) SubmitCustomerKYCDocument(
 	formData["country"] = country
 
 	// makeRequest
-	err := c.makeRequest(
+	err = c.makeRequest(
 		ctx,
+		operation,
 		path,
 		http.MethodPost,
 		nil,
@@ -66,14 +120,14 @@ func (c *Call) SubmitCustomerKYCDocument(
 // VerifyCustomerKYC makes request to Torus to verify a customer kyc request
 func (c *Call) VerifyCustomerKYC(ctx context.Context, customerID, idNumber, kycType string) (interface{}, error) {
 	var (
-		err  error
+		err      error
 		response interface{}
-		path = fmt.Sprintf("%s/%s/%s/%s", kycAPIVersion, customerID, kycType, idNumber)
+		path     = fmt.Sprintf("%s/%s/%s/%s", kycAPIVersion, customerID, kycType, idNumber)
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodPost, nil, nil, nil, nil, &response)
+	err = c.makeRequest(ctx, "VerifyCustomerKYC", path, http.MethodPost, nil, nil, nil, nil, &response)
 
-	return response,err
+	return response, err
 }
 
 // GetVerifyBiometricsLink makes request to get the link to verify biometrics
@@ -84,7 +138,7 @@ func (c *Call) GetVerifyBiometricsLink(ctx context.Context, customerID string) (
 		path     = fmt.Sprintf("%s/%s/biometrics", kycAPIVersion, customerID)
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodGet, nil, nil, nil, nil, &response)
+	err = c.makeRequest(ctx, "GetVerifyBiometricsLink", path, http.MethodGet, nil, nil, nil, nil, &response)
 
 	return response, err
 
@@ -106,7 +160,7 @@ func (c *Call) GetVerifyCustomerKYC(ctx context.Context, customerID string, coun
 	if hasExpiredID != nil {
 		params["has_expired_id"] = *hasExpiredID
 	}
-	err = c.makeRequest(ctx, path, http.MethodGet, nil, params, nil, nil, &response)
+	err = c.makeRequest(ctx, "GetVerifyCustomerKYC", path, http.MethodGet, nil, params, nil, nil, &response)
 
 	return response, err
 
@@ -0,0 +1,41 @@
+package api
+
+import (
+	"github.com/ovalfi/go-sdk/helpers"
+)
+
+// PayloadLogging controls how request and response payloads are written to the logs
+type PayloadLogging int
+
+const (
+	// PayloadLoggingRedacted logs payloads with the fields tagged `sensitive` masked. This is the default
+	PayloadLoggingRedacted PayloadLogging = iota
+	// PayloadLoggingOff never logs payloads, only the method, endpoint and outcome of each call
+	PayloadLoggingOff
+	// PayloadLoggingFull logs payloads as they are sent and received. Card numbers and personal data will reach the logs,
+	// only use it against the sandbox
+	PayloadLoggingFull
+)
+
+// WithPayloadLogging sets how request and response payloads are written to the logs. Defaults to PayloadLoggingRedacted
+func WithPayloadLogging(mode PayloadLogging) Option {
+	return func(o *options) {
+		o.payloadLogging = mode
+	}
+}
+
+// loggable returns the payload as it may be written to the logs, and false when it must not be logged at all
+func (c *Call) loggable(payload interface{}) (interface{}, bool) {
+	if payload == nil {
+		return nil, false
+	}
+
+	switch c.payloadLogging {
+	case PayloadLoggingOff:
+		return nil, false
+	case PayloadLoggingFull:
+		return payload, true
+	default:
+		return helpers.Redact(payload), true
+	}
+}
@@ -0,0 +1,134 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestPayloadLogging(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "n0nce", r.URL.Query().Get("nonce_key"))
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(`{"data":{"full_pan":"4111111111111111","cvv":"123","name_on_card":"Ada Lovelace"}}`))
+		assert.NoError(t, err)
+	}))
+	defer ts.Close()
+
+	tests := map[string]struct {
+		mode       PayloadLogging
+		contains   []string
+		notContain []string
+	}{
+		"redacted by default": {
+			mode:       PayloadLoggingRedacted,
+			contains:   []string{"************1111", "Ada Lovelace", "[REDACTED]"},
+			notContain: []string{"4111111111111111", `"cvv":"123"`, "n0nce"},
+		},
+		"off": {
+			mode:       PayloadLoggingOff,
+			notContain: []string{"1111", "Ada Lovelace", "n0nce"},
+		},
+		"full": {
+			mode:     PayloadLoggingFull,
+			contains: []string{"4111111111111111", "n0nce"},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+			logger := zerolog.New(&buf)
+
+			client, err := NewClient(
+				WithCredentials("secret", "token"),
+				WithBaseURL(ts.URL+"/"),
+				WithLogger(&logger),
+				WithPayloadLogging(tt.mode),
+			)
+			require.NoError(t, err)
+
+			details, err := client.GetCustomerCardSecureDetails(context.Background(), "card-1", "customer-1", "n0nce")
+			require.NoError(t, err)
+			assert.Equal(t, "4111111111111111", details.FullPAN)
+
+			for _, s := range tt.contains {
+				assert.Contains(t, buf.String(), s)
+			}
+			for _, s := range tt.notContain {
+				assert.NotContains(t, buf.String(), s)
+			}
+		})
+	}
+}
+
+func TestIdentityNumberRedactedFromPath(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/kycs/customer-1/bvn/22212345678", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"status":"verified"}}`))
+	}))
+
+	var buf bytes.Buffer
+	logger := zerolog.New(&buf)
+	exporter := tracetest.NewInMemoryExporter()
+
+	client, err := NewClient(
+		WithCredentials("secret", "token"),
+		WithBaseURL(ts.URL+"/"),
+		WithLogger(&logger),
+		WithPayloadLogging(PayloadLoggingFull),
+		WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))),
+	)
+	require.NoError(t, err)
+
+	_, err = client.VerifyCustomerKYC(context.Background(), "customer-1", "22212345678", "bvn")
+	require.NoError(t, err)
+
+	// transport errors carry the URL of the request
+	ts.Close()
+	_, err = client.VerifyCustomerKYC(context.Background(), "customer-1", "22212345678", "bvn")
+	require.Error(t, err)
+
+	assert.NotContains(t, err.Error(), "22212345678")
+	assert.Contains(t, buf.String(), "v1/kycs/customer-1/bvn/[REDACTED]")
+	assert.NotContains(t, buf.String(), "22212345678")
+
+	spans := exporter.GetSpans().Snapshots()
+	require.Len(t, spans, 2)
+	for _, span := range spans {
+		assert.Equal(t, "v1/kycs/customer-1/bvn/[REDACTED]", spanAttributes(span)[attrEndpoint].AsString())
+		for _, event := range span.Events() {
+			for _, attribute := range event.Attributes {
+				assert.NotContains(t, attribute.Value.Emit(), "22212345678")
+			}
+		}
+		assert.NotContains(t, span.Status().Description, "22212345678")
+	}
+}
+
+func TestTransportErrorBodyNotLogged(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"full_pan":"4111111111111111"`))
+	}))
+	defer ts.Close()
+
+	var buf bytes.Buffer
+	logger := zerolog.New(&buf)
+
+	client, err := NewClient(WithCredentials("secret", "token"), WithBaseURL(ts.URL+"/"), WithLogger(&logger))
+	require.NoError(t, err)
+
+	_, err = client.GetCustomerCardSecureDetails(context.Background(), "card-1", "customer-1", "n0nce")
+	require.Error(t, err)
+	assert.NotContains(t, buf.String(), "4111111111111111")
+}
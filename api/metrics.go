@@ -0,0 +1,52 @@
+package api
+
+import (
+	"time"
+)
+
+type (
+	// MetricsRecorder receives the measurements of every HTTP request sent by the SDK
+	MetricsRecorder interface {
+		// IncInFlight is called when a request to the API starts
+		IncInFlight(operation string)
+		// DecInFlight is called when a request to the API completes, whatever its outcome
+		DecInFlight(operation string)
+		// ObserveRequest is called with the outcome of every request to the API, including retried ones
+		ObserveRequest(observation RequestObservation)
+	}
+
+	// RequestObservation is the outcome of a single request to the API
+	RequestObservation struct {
+		// Operation is the name of the RemoteCalls method, e.g. GetExchangeRates
+		Operation string
+		// BusinessID is the business of the client, set for the clients of a ClientPool
+		BusinessID string
+		// StatusCode is the HTTP status code of the response, 0 when no response was received
+		StatusCode int
+		// ErrorID is the error identifier sent by the API, if any
+		ErrorID string
+		// ErrorClass is the low cardinality class of the error, empty on success
+		ErrorClass string
+		// Duration is the time spent waiting for the response
+		Duration time.Duration
+	}
+
+	// noopMetrics is the MetricsRecorder used when none is configured
+	noopMetrics struct{}
+)
+
+// WithMetrics sets the recorder that receives request counts, latencies, in-flight requests and errors
+func WithMetrics(recorder MetricsRecorder) Option {
+	return func(o *options) {
+		o.metrics = recorder
+	}
+}
+
+// IncInFlight implements MetricsRecorder
+func (noopMetrics) IncInFlight(string) {}
+
+// DecInFlight implements MetricsRecorder
+func (noopMetrics) DecInFlight(string) {}
+
+// ObserveRequest implements MetricsRecorder
+func (noopMetrics) ObserveRequest(RequestObservation) {}
@@ -0,0 +1,55 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/ovalfi/go-sdk/model"
+)
+
+type (
+	// Request describes a call to the Oval API as seen by middlewares. Middlewares may modify it before calling the next handler
+	Request struct {
+		// Operation is the name of the RemoteCalls method being executed, e.g. InitiateTerminalTransfer
+		Operation string
+		// Group is the endpoint group of the operation
+		Group EndpointGroup
+		// Method is the HTTP method
+		Method string
+		// Path is the endpoint path relative to the base URL
+		Path string
+		// Params are the query parameters
+		Params map[string]interface{}
+		// FormData are the multipart form fields
+		FormData map[string]interface{}
+		// Body is the JSON request body
+		Body interface{}
+		// Header holds the extra headers sent with the request
+		Header http.Header
+
+		// SignedReference is the reference signed with the API secret in the Signature header, nil when the endpoint is not signed
+		SignedReference *string
+	}
+
+	// Handler executes a Request and returns the decoded response envelope
+	Handler func(ctx context.Context, request *Request) (*model.GenericResponse, error)
+
+	// Middleware wraps a Handler to add behaviour around every call. It may short-circuit the call by not invoking next
+	Middleware func(next Handler) Handler
+)
+
+// WithMiddleware appends middlewares to the client chain. The first middleware registered is the outermost one:
+// it sees the request first and the response last
+func WithMiddleware(middlewares ...Middleware) Option {
+	return func(o *options) {
+		o.middlewares = append(o.middlewares, middlewares...)
+	}
+}
+
+// chain wraps the handler with the client middlewares
+func (c *Call) chain(handler Handler) Handler {
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		handler = c.middlewares[i](handler)
+	}
+	return handler
+}
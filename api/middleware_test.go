@@ -0,0 +1,186 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ovalfi/go-sdk/model"
+)
+
+func TestMiddlewareChain(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "tenant-eu", r.Header.Get("X-Tenant"))
+		assert.NotEmpty(t, r.Header.Get(model.IdempotencyKeyHeaderKey))
+		assert.NotEmpty(t, r.Header.Get("Signature"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(`{"data":{"id":"11111111-1111-1111-1111-111111111111","reference":"ref-1"}}`))
+		assert.NoError(t, err)
+	}))
+	defer ts.Close()
+
+	var trace []string
+	record := func(name string) Middleware {
+		return func(next Handler) Handler {
+			return func(ctx context.Context, request *Request) (*model.GenericResponse, error) {
+				trace = append(trace, name+" before "+request.Operation)
+				response, err := next(ctx, request)
+				trace = append(trace, name+" after")
+				return response, err
+			}
+		}
+	}
+	tag := func(next Handler) Handler {
+		return func(ctx context.Context, request *Request) (*model.GenericResponse, error) {
+			assert.Equal(t, http.MethodPost, request.Method)
+			assert.Equal(t, "v1/deposit", request.Path)
+			assert.Equal(t, model.InitiateDepositRequest{Reference: "ref-1"}, request.Body)
+			request.Header.Set("X-Tenant", "tenant-eu")
+
+			response, err := next(ctx, request)
+			assert.NoError(t, err)
+			assert.Contains(t, string(response.Data), `"reference":"ref-1"`)
+			return response, err
+		}
+	}
+
+	client, err := NewClient(
+		WithCredentials("secret", "token"),
+		WithBaseURL(ts.URL+"/"),
+		WithMiddleware(record("outer"), record("inner")),
+		WithMiddleware(tag),
+	)
+	assert.NoError(t, err)
+
+	deposit, err := client.InitiateDeposit(context.Background(), model.InitiateDepositRequest{Reference: "ref-1"})
+	assert.NoError(t, err)
+	assert.Equal(t, "ref-1", deposit.Reference)
+	assert.Equal(t, []string{
+		"outer before InitiateDeposit",
+		"inner before InitiateDeposit",
+		"inner after",
+		"outer after",
+	}, trace)
+}
+
+func TestMiddlewareShortCircuit(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("request should not reach the server")
+	}))
+	defer ts.Close()
+
+	errInjected := errors.New("injected fault")
+	tests := map[string]struct {
+		middleware  Middleware
+		expected    float64
+		expectedErr error
+	}{
+		"canned response": {
+			middleware: func(next Handler) Handler {
+				return func(ctx context.Context, request *Request) (*model.GenericResponse, error) {
+					return &model.GenericResponse{Data: json.RawMessage(`{"USD":42.5}`)}, nil
+				}
+			},
+			expected: 42.5,
+		},
+		"fault injection": {
+			middleware: func(next Handler) Handler {
+				return func(ctx context.Context, request *Request) (*model.GenericResponse, error) {
+					return nil, errInjected
+				}
+			},
+			expectedErr: errInjected,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, err := NewClient(WithCredentials("secret", "token"), WithBaseURL(ts.URL+"/"), WithMiddleware(tt.middleware))
+			assert.NoError(t, err)
+
+			balances, err := client.GetBalances(context.Background())
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, balances["USD"])
+		})
+	}
+}
+
+func TestDeprecatedMethodsKeepTheirOperation(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	defer ts.Close()
+
+	var operations []string
+	record := func(next Handler) Handler {
+		return func(ctx context.Context, request *Request) (*model.GenericResponse, error) {
+			operations = append(operations, request.Operation+" "+string(request.Group))
+			return next(ctx, request)
+		}
+	}
+
+	client, err := NewClient(WithCredentials("secret", "token"), WithBaseURL(ts.URL+"/"), WithMiddleware(record))
+	require.NoError(t, err)
+
+	document, err := os.CreateTemp(t.TempDir(), "payout-*.csv")
+	require.NoError(t, err)
+	_, err = document.WriteString("amount\n10\n")
+	require.NoError(t, err)
+	_, err = document.Seek(0, io.SeekStart)
+	require.NoError(t, err)
+	defer document.Close()
+
+	ctx := context.Background()
+	_, err = client.GetTransactions(ctx, "", "", "", "", "", nil, nil, nil)
+	require.NoError(t, err)
+	_, err = client.ListTransactions(ctx, model.TransactionFilter{}, nil)
+	require.NoError(t, err)
+	_, err = client.GetAllCustomers(ctx)
+	require.NoError(t, err)
+	_, err = client.InitiatePayout(ctx, "USD", "bulk", "individual", "", nil, document)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"GetTransactions payments",
+		"ListTransactions payments",
+		"GetAllCustomers customers",
+		"InitiatePayout payments",
+	}, operations)
+}
+
+func TestOperationTimeoutAppliesToDeprecatedMethods(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(
+		WithCredentials("secret", "token"),
+		WithBaseURL(ts.URL+"/"),
+		WithRetries(NoRetryPolicy),
+		WithTimeoutPolicy(TimeoutPolicy{Operations: map[string]time.Duration{"GetTransactions": time.Nanosecond}}),
+	)
+	require.NoError(t, err)
+
+	_, err = client.GetTransactions(context.Background(), "", "", "", "", "", nil, nil, nil)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	_, err = client.ListTransactions(context.Background(), model.TransactionFilter{}, nil)
+	assert.NoError(t, err)
+}
@@ -10,6 +10,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	api "github.com/ovalfi/go-sdk/api"
 	model "github.com/ovalfi/go-sdk/model"
 )
 
@@ -51,6 +52,76 @@ func (mr *MockRemoteCallsMockRecorder) AuthenticateCustomerPaymentIntent(ctx, re
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticateCustomerPaymentIntent", reflect.TypeOf((*MockRemoteCalls)(nil).AuthenticateCustomerPaymentIntent), ctx, request)
 }
 
+// Beneficiaries mocks base method.
+func (m *MockRemoteCalls) Beneficiaries(ctx context.Context, currency string, opts api.ListOptions) api.BeneficiarySeq {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Beneficiaries", ctx, currency, opts)
+	ret0, _ := ret[0].(api.BeneficiarySeq)
+	return ret0
+}
+
+// Beneficiaries indicates an expected call of Beneficiaries.
+func (mr *MockRemoteCallsMockRecorder) Beneficiaries(ctx, currency, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Beneficiaries", reflect.TypeOf((*MockRemoteCalls)(nil).Beneficiaries), ctx, currency, opts)
+}
+
+// BillerProducts mocks base method.
+func (m *MockRemoteCalls) BillerProducts(ctx context.Context, category, biller, country string, billingType *string, opts api.ListOptions) api.BillerProductSeq {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BillerProducts", ctx, category, biller, country, billingType, opts)
+	ret0, _ := ret[0].(api.BillerProductSeq)
+	return ret0
+}
+
+// BillerProducts indicates an expected call of BillerProducts.
+func (mr *MockRemoteCallsMockRecorder) BillerProducts(ctx, category, biller, country, billingType, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BillerProducts", reflect.TypeOf((*MockRemoteCalls)(nil).BillerProducts), ctx, category, biller, country, billingType, opts)
+}
+
+// BulkPayouts mocks base method.
+func (m *MockRemoteCalls) BulkPayouts(ctx context.Context, filter model.PayoutFilter, opts api.BulkOptions) <-chan api.PayoutItem {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkPayouts", ctx, filter, opts)
+	ret0, _ := ret[0].(<-chan api.PayoutItem)
+	return ret0
+}
+
+// BulkPayouts indicates an expected call of BulkPayouts.
+func (mr *MockRemoteCallsMockRecorder) BulkPayouts(ctx, filter, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkPayouts", reflect.TypeOf((*MockRemoteCalls)(nil).BulkPayouts), ctx, filter, opts)
+}
+
+// BulkTerminalTransfers mocks base method.
+func (m *MockRemoteCalls) BulkTerminalTransfers(ctx context.Context, filter model.TerminalTransferFilter, opts api.BulkOptions) <-chan api.TerminalTransferItem {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkTerminalTransfers", ctx, filter, opts)
+	ret0, _ := ret[0].(<-chan api.TerminalTransferItem)
+	return ret0
+}
+
+// BulkTerminalTransfers indicates an expected call of BulkTerminalTransfers.
+func (mr *MockRemoteCallsMockRecorder) BulkTerminalTransfers(ctx, filter, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkTerminalTransfers", reflect.TypeOf((*MockRemoteCalls)(nil).BulkTerminalTransfers), ctx, filter, opts)
+}
+
+// BulkTransactions mocks base method.
+func (m *MockRemoteCalls) BulkTransactions(ctx context.Context, filter model.TransactionFilter, opts api.BulkOptions) <-chan api.TransactionItem {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkTransactions", ctx, filter, opts)
+	ret0, _ := ret[0].(<-chan api.TransactionItem)
+	return ret0
+}
+
+// BulkTransactions indicates an expected call of BulkTransactions.
+func (mr *MockRemoteCallsMockRecorder) BulkTransactions(ctx, filter, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkTransactions", reflect.TypeOf((*MockRemoteCalls)(nil).BulkTransactions), ctx, filter, opts)
+}
+
 // CancelBatchTransaction mocks base method.
 func (m *MockRemoteCalls) CancelBatchTransaction(ctx context.Context, batchDate, transactionType, currency, reason string) error {
 	m.ctrl.T.Helper()
@@ -93,6 +164,20 @@ func (mr *MockRemoteCallsMockRecorder) CancelTransaction(ctx, transactionID, tra
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelTransaction", reflect.TypeOf((*MockRemoteCalls)(nil).CancelTransaction), ctx, transactionID, transactionType, reason)
 }
 
+// CircuitBreakerStates mocks base method.
+func (m *MockRemoteCalls) CircuitBreakerStates() map[api.EndpointGroup]api.BreakerState {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CircuitBreakerStates")
+	ret0, _ := ret[0].(map[api.EndpointGroup]api.BreakerState)
+	return ret0
+}
+
+// CircuitBreakerStates indicates an expected call of CircuitBreakerStates.
+func (mr *MockRemoteCallsMockRecorder) CircuitBreakerStates() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CircuitBreakerStates", reflect.TypeOf((*MockRemoteCalls)(nil).CircuitBreakerStates))
+}
+
 // CompleteCustomerPaymentIntent mocks base method.
 func (m *MockRemoteCalls) CompleteCustomerPaymentIntent(ctx context.Context, request model.CompleteCustomerPaymentIntentRequest) (model.CreateCustomerPaymentIntentResponse, error) {
 	m.ctrl.T.Helper()
@@ -227,6 +312,48 @@ func (mr *MockRemoteCallsMockRecorder) CryptoWithdrawal(ctx, request interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CryptoWithdrawal", reflect.TypeOf((*MockRemoteCalls)(nil).CryptoWithdrawal), ctx, request)
 }
 
+// CurrencySwaps mocks base method.
+func (m *MockRemoteCalls) CurrencySwaps(ctx context.Context, filter model.SwapFilter, opts api.ListOptions) api.CurrencySwapSeq {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CurrencySwaps", ctx, filter, opts)
+	ret0, _ := ret[0].(api.CurrencySwapSeq)
+	return ret0
+}
+
+// CurrencySwaps indicates an expected call of CurrencySwaps.
+func (mr *MockRemoteCallsMockRecorder) CurrencySwaps(ctx, filter, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CurrencySwaps", reflect.TypeOf((*MockRemoteCalls)(nil).CurrencySwaps), ctx, filter, opts)
+}
+
+// CustomerPaymentCards mocks base method.
+func (m *MockRemoteCalls) CustomerPaymentCards(ctx context.Context, customerID string, filter model.PaymentCardFilter, opts api.ListOptions) api.PaymentCardSeq {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CustomerPaymentCards", ctx, customerID, filter, opts)
+	ret0, _ := ret[0].(api.PaymentCardSeq)
+	return ret0
+}
+
+// CustomerPaymentCards indicates an expected call of CustomerPaymentCards.
+func (mr *MockRemoteCallsMockRecorder) CustomerPaymentCards(ctx, customerID, filter, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CustomerPaymentCards", reflect.TypeOf((*MockRemoteCalls)(nil).CustomerPaymentCards), ctx, customerID, filter, opts)
+}
+
+// Customers mocks base method.
+func (m *MockRemoteCalls) Customers(ctx context.Context, filter model.CustomerFilter, opts api.ListOptions) api.CustomerSeq {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Customers", ctx, filter, opts)
+	ret0, _ := ret[0].(api.CustomerSeq)
+	return ret0
+}
+
+// Customers indicates an expected call of Customers.
+func (mr *MockRemoteCallsMockRecorder) Customers(ctx, filter, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Customers", reflect.TypeOf((*MockRemoteCalls)(nil).Customers), ctx, filter, opts)
+}
+
 // DebitPaymentCard mocks base method.
 func (m *MockRemoteCalls) DebitPaymentCard(ctx context.Context, request model.DebitCustomerPaymentCardRequest) (model.Deposit, error) {
 	m.ctrl.T.Helper()
@@ -673,6 +800,21 @@ func (mr *MockRemoteCallsMockRecorder) GetCustomerByID(ctx, customerID interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomerByID", reflect.TypeOf((*MockRemoteCalls)(nil).GetCustomerByID), ctx, customerID)
 }
 
+// GetCustomerByReference mocks base method.
+func (m *MockRemoteCalls) GetCustomerByReference(ctx context.Context, reference string) (model.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomerByReference", ctx, reference)
+	ret0, _ := ret[0].(model.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomerByReference indicates an expected call of GetCustomerByReference.
+func (mr *MockRemoteCallsMockRecorder) GetCustomerByReference(ctx, reference interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomerByReference", reflect.TypeOf((*MockRemoteCalls)(nil).GetCustomerByReference), ctx, reference)
+}
+
 // GetCustomerCardByID mocks base method.
 func (m *MockRemoteCalls) GetCustomerCardByID(ctx context.Context, cardID string) (model.Card, error) {
 	m.ctrl.T.Helper()
@@ -1138,6 +1280,21 @@ func (mr *MockRemoteCallsMockRecorder) InitiatePayout(ctx, currency, payoutType,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitiatePayout", reflect.TypeOf((*MockRemoteCalls)(nil).InitiatePayout), ctx, currency, payoutType, beneficiaryType, remarks, customerID, document)
 }
 
+// InitiatePayoutUpload mocks base method.
+func (m *MockRemoteCalls) InitiatePayoutUpload(ctx context.Context, currency, payoutType, beneficiaryType, remarks string, customerID *string, document model.Upload) (model.PayoutDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InitiatePayoutUpload", ctx, currency, payoutType, beneficiaryType, remarks, customerID, document)
+	ret0, _ := ret[0].(model.PayoutDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InitiatePayoutUpload indicates an expected call of InitiatePayoutUpload.
+func (mr *MockRemoteCallsMockRecorder) InitiatePayoutUpload(ctx, currency, payoutType, beneficiaryType, remarks, customerID, document interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitiatePayoutUpload", reflect.TypeOf((*MockRemoteCalls)(nil).InitiatePayoutUpload), ctx, currency, payoutType, beneficiaryType, remarks, customerID, document)
+}
+
 // InitiateTerminalTransfer mocks base method.
 func (m *MockRemoteCalls) InitiateTerminalTransfer(ctx context.Context, request model.InitiateTerminalTransferRequest) (model.TerminalTransfer, error) {
 	m.ctrl.T.Helper()
@@ -1213,6 +1370,112 @@ func (mr *MockRemoteCallsMockRecorder) IntraTransfer(ctx, request interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IntraTransfer", reflect.TypeOf((*MockRemoteCalls)(nil).IntraTransfer), ctx, request)
 }
 
+// InvalidateReferenceData mocks base method.
+func (m *MockRemoteCalls) InvalidateReferenceData(operations ...string) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range operations {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "InvalidateReferenceData", varargs...)
+}
+
+// InvalidateReferenceData indicates an expected call of InvalidateReferenceData.
+func (mr *MockRemoteCallsMockRecorder) InvalidateReferenceData(operations ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateReferenceData", reflect.TypeOf((*MockRemoteCalls)(nil).InvalidateReferenceData), operations...)
+}
+
+// ListCurrencySwaps mocks base method.
+func (m *MockRemoteCalls) ListCurrencySwaps(ctx context.Context, filter model.SwapFilter, page *model.Page) (model.AllSwapsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCurrencySwaps", ctx, filter, page)
+	ret0, _ := ret[0].(model.AllSwapsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCurrencySwaps indicates an expected call of ListCurrencySwaps.
+func (mr *MockRemoteCallsMockRecorder) ListCurrencySwaps(ctx, filter, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCurrencySwaps", reflect.TypeOf((*MockRemoteCalls)(nil).ListCurrencySwaps), ctx, filter, page)
+}
+
+// ListCustomerPaymentCards mocks base method.
+func (m *MockRemoteCalls) ListCustomerPaymentCards(ctx context.Context, customerID string, filter model.PaymentCardFilter, page *model.Page) (model.AllPaymentCardsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCustomerPaymentCards", ctx, customerID, filter, page)
+	ret0, _ := ret[0].(model.AllPaymentCardsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCustomerPaymentCards indicates an expected call of ListCustomerPaymentCards.
+func (mr *MockRemoteCallsMockRecorder) ListCustomerPaymentCards(ctx, customerID, filter, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomerPaymentCards", reflect.TypeOf((*MockRemoteCalls)(nil).ListCustomerPaymentCards), ctx, customerID, filter, page)
+}
+
+// ListCustomers mocks base method.
+func (m *MockRemoteCalls) ListCustomers(ctx context.Context, filter model.CustomerFilter, page *model.Page) (model.AllCustomersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCustomers", ctx, filter, page)
+	ret0, _ := ret[0].(model.AllCustomersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCustomers indicates an expected call of ListCustomers.
+func (mr *MockRemoteCallsMockRecorder) ListCustomers(ctx, filter, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomers", reflect.TypeOf((*MockRemoteCalls)(nil).ListCustomers), ctx, filter, page)
+}
+
+// ListPayouts mocks base method.
+func (m *MockRemoteCalls) ListPayouts(ctx context.Context, filter model.PayoutFilter, page *model.Page) (model.AllPayoutsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPayouts", ctx, filter, page)
+	ret0, _ := ret[0].(model.AllPayoutsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPayouts indicates an expected call of ListPayouts.
+func (mr *MockRemoteCallsMockRecorder) ListPayouts(ctx, filter, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPayouts", reflect.TypeOf((*MockRemoteCalls)(nil).ListPayouts), ctx, filter, page)
+}
+
+// ListTerminalTransfers mocks base method.
+func (m *MockRemoteCalls) ListTerminalTransfers(ctx context.Context, filter model.TerminalTransferFilter, page *model.Page) (model.AllTransfersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTerminalTransfers", ctx, filter, page)
+	ret0, _ := ret[0].(model.AllTransfersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTerminalTransfers indicates an expected call of ListTerminalTransfers.
+func (mr *MockRemoteCallsMockRecorder) ListTerminalTransfers(ctx, filter, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTerminalTransfers", reflect.TypeOf((*MockRemoteCalls)(nil).ListTerminalTransfers), ctx, filter, page)
+}
+
+// ListTransactions mocks base method.
+func (m *MockRemoteCalls) ListTransactions(ctx context.Context, filter model.TransactionFilter, page *model.Page) (model.AllTransactionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTransactions", ctx, filter, page)
+	ret0, _ := ret[0].(model.AllTransactionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTransactions indicates an expected call of ListTransactions.
+func (mr *MockRemoteCallsMockRecorder) ListTransactions(ctx, filter, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransactions", reflect.TypeOf((*MockRemoteCalls)(nil).ListTransactions), ctx, filter, page)
+}
+
 // MockDeposit mocks base method.
 func (m *MockRemoteCalls) MockDeposit(ctx context.Context, request model.MockCustomerDepositRequest) error {
 	m.ctrl.T.Helper()
@@ -1242,6 +1505,20 @@ func (mr *MockRemoteCallsMockRecorder) PayBill(ctx, request interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PayBill", reflect.TypeOf((*MockRemoteCalls)(nil).PayBill), ctx, request)
 }
 
+// Payouts mocks base method.
+func (m *MockRemoteCalls) Payouts(ctx context.Context, filter model.PayoutFilter, opts api.ListOptions) api.PayoutSeq {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Payouts", ctx, filter, opts)
+	ret0, _ := ret[0].(api.PayoutSeq)
+	return ret0
+}
+
+// Payouts indicates an expected call of Payouts.
+func (mr *MockRemoteCallsMockRecorder) Payouts(ctx, filter, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Payouts", reflect.TypeOf((*MockRemoteCalls)(nil).Payouts), ctx, filter, opts)
+}
+
 // ProcessCustomerPaymentToken mocks base method.
 func (m *MockRemoteCalls) ProcessCustomerPaymentToken(ctx context.Context, request model.CustomerPaymentTokenRequest) error {
 	m.ctrl.T.Helper()
@@ -1297,6 +1574,18 @@ func (mr *MockRemoteCallsMockRecorder) RunInSandboxMode() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInSandboxMode", reflect.TypeOf((*MockRemoteCalls)(nil).RunInSandboxMode))
 }
 
+// SetRetryPolicy mocks base method.
+func (m *MockRemoteCalls) SetRetryPolicy(policy api.RetryPolicy) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetRetryPolicy", policy)
+}
+
+// SetRetryPolicy indicates an expected call of SetRetryPolicy.
+func (mr *MockRemoteCallsMockRecorder) SetRetryPolicy(policy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRetryPolicy", reflect.TypeOf((*MockRemoteCalls)(nil).SetRetryPolicy), policy)
+}
+
 // SubmitCustomerKYCDocument mocks base method.
 func (m *MockRemoteCalls) SubmitCustomerKYCDocument(ctx context.Context, customerID string, frontDocument, backDocument *os.File, documentType, country string) (model.KYCResponse, error) {
 	m.ctrl.T.Helper()
@@ -1312,6 +1601,21 @@ func (mr *MockRemoteCallsMockRecorder) SubmitCustomerKYCDocument(ctx, customerID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitCustomerKYCDocument", reflect.TypeOf((*MockRemoteCalls)(nil).SubmitCustomerKYCDocument), ctx, customerID, frontDocument, backDocument, documentType, country)
 }
 
+// SubmitCustomerKYCDocumentUpload mocks base method.
+func (m *MockRemoteCalls) SubmitCustomerKYCDocumentUpload(ctx context.Context, customerID string, frontDocument model.Upload, backDocument *model.Upload, documentType, country string) (model.KYCResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitCustomerKYCDocumentUpload", ctx, customerID, frontDocument, backDocument, documentType, country)
+	ret0, _ := ret[0].(model.KYCResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitCustomerKYCDocumentUpload indicates an expected call of SubmitCustomerKYCDocumentUpload.
+func (mr *MockRemoteCallsMockRecorder) SubmitCustomerKYCDocumentUpload(ctx, customerID, frontDocument, backDocument, documentType, country interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitCustomerKYCDocumentUpload", reflect.TypeOf((*MockRemoteCalls)(nil).SubmitCustomerKYCDocumentUpload), ctx, customerID, frontDocument, backDocument, documentType, country)
+}
+
 // SubmitSTR mocks base method.
 func (m *MockRemoteCalls) SubmitSTR(ctx context.Context, request model.SubmitSTRRequest) error {
 	m.ctrl.T.Helper()
@@ -1326,6 +1630,34 @@ func (mr *MockRemoteCallsMockRecorder) SubmitSTR(ctx, request interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitSTR", reflect.TypeOf((*MockRemoteCalls)(nil).SubmitSTR), ctx, request)
 }
 
+// TerminalTransfers mocks base method.
+func (m *MockRemoteCalls) TerminalTransfers(ctx context.Context, filter model.TerminalTransferFilter, opts api.ListOptions) api.TerminalTransferSeq {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TerminalTransfers", ctx, filter, opts)
+	ret0, _ := ret[0].(api.TerminalTransferSeq)
+	return ret0
+}
+
+// TerminalTransfers indicates an expected call of TerminalTransfers.
+func (mr *MockRemoteCallsMockRecorder) TerminalTransfers(ctx, filter, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminalTransfers", reflect.TypeOf((*MockRemoteCalls)(nil).TerminalTransfers), ctx, filter, opts)
+}
+
+// Transactions mocks base method.
+func (m *MockRemoteCalls) Transactions(ctx context.Context, filter model.TransactionFilter, opts api.ListOptions) api.TransactionSeq {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transactions", ctx, filter, opts)
+	ret0, _ := ret[0].(api.TransactionSeq)
+	return ret0
+}
+
+// Transactions indicates an expected call of Transactions.
+func (mr *MockRemoteCallsMockRecorder) Transactions(ctx, filter, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transactions", reflect.TypeOf((*MockRemoteCalls)(nil).Transactions), ctx, filter, opts)
+}
+
 // UpdateCustomer mocks base method.
 func (m *MockRemoteCalls) UpdateCustomer(ctx context.Context, request model.UpdateCustomerRequest) (model.Customer, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,244 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ovalfi/go-sdk/model"
+)
+
+// Environment is the Oval API environment a client talks to
+type Environment string
+
+const (
+	// Sandbox is the Oval sandbox environment
+	Sandbox Environment = "sandbox"
+	// Production is the Oval live environment
+	Production Environment = "production"
+)
+
+var (
+	// ErrMissingCredentials when a client is created without an API secret or a bearer token
+	ErrMissingCredentials = errors.New("api secret and bearer token are required")
+	// ErrUnknownEnvironment when a client is created for an environment that is not supported
+	ErrUnknownEnvironment = errors.New("unknown environment")
+)
+
+type (
+	// Option configures the client created by NewClient
+	Option func(*options)
+
+	// options holds the settings collected from the Option list before the client is built
+	options struct {
+		environment Environment
+		baseURL     string
+		client      *resty.Client
+		timeout     time.Duration
+		userAgent   string
+		logger      *zerolog.Logger
+		apiSecret   string
+		bearerToken string
+		credentials CredentialsProvider
+		retryPolicy RetryPolicy
+		debug       bool
+		middlewares []Middleware
+
+		tracerProvider trace.TracerProvider
+		propagator     propagation.TextMapPropagator
+		metrics        MetricsRecorder
+		payloadLogging PayloadLogging
+		rateLimits     map[EndpointGroup]RateLimit
+		circuitBreaker *CircuitBreakerSettings
+		maxUploadSize  int64
+		businessID     string
+		timeoutPolicy  TimeoutPolicy
+		cache          *CacheSettings
+	}
+)
+
+// WithEnvironment selects the environment, and therefore the base URL, the client talks to. Defaults to Sandbox.
+// Sandbox-only operations such as MockDeposit are enabled by an explicit Sandbox or by the sandbox base URL only
+func WithEnvironment(environment Environment) Option {
+	return func(o *options) {
+		o.environment = environment
+	}
+}
+
+// WithBaseURL overrides the base URL derived from the environment
+func WithBaseURL(baseURL string) Option {
+	return func(o *options) {
+		o.baseURL = baseURL
+	}
+}
+
+// WithHTTPClient sets the http.Client used to send requests
+func WithHTTPClient(client *http.Client) Option {
+	return func(o *options) {
+		o.client = resty.NewWithClient(client)
+	}
+}
+
+// WithRestyClient sets the resty.Client used to send requests
+func WithRestyClient(client *resty.Client) Option {
+	return func(o *options) {
+		o.client = client
+	}
+}
+
+// WithTimeout sets the overall timeout of a single HTTP request. It applies to the requests of this client only,
+// a shared resty.Client or http.Client keeps its own timeout
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.timeout = timeout
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request
+func WithUserAgent(userAgent string) Option {
+	return func(o *options) {
+		o.userAgent = userAgent
+	}
+}
+
+// WithLogger sets the logger the client writes to. Defaults to a no-op logger
+func WithLogger(logger *zerolog.Logger) Option {
+	return func(o *options) {
+		o.logger = logger
+	}
+}
+
+// WithCredentials sets the API secret used to sign requests and the bearer token used to authenticate them
+func WithCredentials(apiSecret, bearerToken string) Option {
+	return func(o *options) {
+		o.apiSecret = apiSecret
+		o.bearerToken = bearerToken
+	}
+}
+
+// WithRetries sets the retry policy used for every call made by the client. Defaults to DefaultRetryPolicy
+func WithRetries(policy RetryPolicy) Option {
+	return func(o *options) {
+		o.retryPolicy = policy
+	}
+}
+
+// WithDebug dumps the raw requests and responses of this client through resty's debug log.
+// Only the requests made by this client are affected, even when the resty.Client is shared
+func WithDebug(debug bool) Option {
+	return func(o *options) {
+		o.debug = debug
+	}
+}
+
+// NewClient initialises the object Call from the given options, refusing to start without credentials
+func NewClient(opts ...Option) (RemoteCalls, error) {
+	o := newOptions(opts...)
+	if o.credentials == nil && (o.apiSecret == "" || o.bearerToken == "") {
+		return nil, ErrMissingCredentials
+	}
+
+	call, err := newCall(o)
+	if err != nil {
+		return nil, err
+	}
+	return RemoteCalls(call), nil
+}
+
+// newOptions applies the options over the defaults
+func newOptions(opts ...Option) *options {
+	o := &options{
+		retryPolicy:   DefaultRetryPolicy,
+		timeoutPolicy: DefaultTimeoutPolicy,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// newCall builds the Call described by the options
+func newCall(o *options) (*Call, error) {
+	baseURL := o.baseURL
+	if baseURL == "" {
+		switch o.environment {
+		case Sandbox, "":
+			baseURL = model.SandboxBaseURL
+		case Production:
+			baseURL = model.ProductionBaseURL
+		default:
+			return nil, ErrUnknownEnvironment
+		}
+	}
+
+	client := o.client
+	if client == nil {
+		client = resty.New()
+	}
+	logger := zerolog.Nop()
+	if o.logger != nil {
+		logger = *o.logger
+	}
+
+	logContext := logger.With().Str("sdk", "ovalfi")
+	if o.businessID != "" {
+		logContext = logContext.Str(model.LogBusinessID, o.businessID)
+	}
+
+	call := &Call{
+		client:      client,
+		logger:      logContext.Logger(),
+		baseURL:     baseURL,
+		credentials: o.credentials,
+		userAgent:   o.userAgent,
+		sandboxMode: !isProductionURL(baseURL) && (o.environment == Sandbox || isSandboxURL(baseURL)),
+		debug:       o.debug,
+		timeout:     o.timeout,
+		retryPolicy: o.retryPolicy,
+		middlewares: o.middlewares,
+		metrics:     o.metrics,
+		limiters:    newLimiters(o.rateLimits),
+		cache:       newResponseCache(o.cache),
+
+		breakerSettings: o.circuitBreaker,
+		maxUploadSize:   o.maxUploadSize,
+		businessID:      o.businessID,
+		timeoutPolicy:   o.timeoutPolicy,
+
+		payloadLogging: o.payloadLogging,
+	}
+
+	if call.credentials == nil {
+		call.credentials = StaticCredentials(o.apiSecret, o.bearerToken)
+	}
+
+	if call.metrics == nil {
+		call.metrics = noopMetrics{}
+	}
+
+	if o.tracerProvider != nil {
+		call.tracer = o.tracerProvider.Tracer(tracerName)
+		call.propagator = o.propagator
+		if call.propagator == nil {
+			call.propagator = propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+		}
+	}
+
+	return call, nil
+}
+
+// isProductionURL reports whether a base URL points at the production environment, with or without a trailing slash
+func isProductionURL(baseURL string) bool {
+	return strings.TrimSuffix(baseURL, "/") == strings.TrimSuffix(model.ProductionBaseURL, "/")
+}
+
+// isSandboxURL reports whether a base URL points at the sandbox environment, with or without a trailing slash
+func isSandboxURL(baseURL string) bool {
+	return strings.TrimSuffix(baseURL, "/") == strings.TrimSuffix(model.SandboxBaseURL, "/")
+}
@@ -0,0 +1,142 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ovalfi/go-sdk/model"
+)
+
+func TestNewClient(t *testing.T) {
+	tests := map[string]struct {
+		opts            []Option
+		expectedErr     error
+		expectedBaseURL string
+	}{
+		"defaults to sandbox": {
+			opts:            []Option{WithCredentials("secret", "token")},
+			expectedBaseURL: model.SandboxBaseURL,
+		},
+		"production environment": {
+			opts:            []Option{WithCredentials("secret", "token"), WithEnvironment(Production)},
+			expectedBaseURL: model.ProductionBaseURL,
+		},
+		"base url overrides environment": {
+			opts:            []Option{WithCredentials("secret", "token"), WithEnvironment(Production), WithBaseURL("http://localhost/")},
+			expectedBaseURL: "http://localhost/",
+		},
+		"missing credentials": {
+			opts:        []Option{WithEnvironment(Production)},
+			expectedErr: ErrMissingCredentials,
+		},
+		"missing bearer token": {
+			opts:        []Option{WithCredentials("secret", "")},
+			expectedErr: ErrMissingCredentials,
+		},
+		"unknown environment": {
+			opts:        []Option{WithCredentials("secret", "token"), WithEnvironment("staging")},
+			expectedErr: ErrUnknownEnvironment,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, err := NewClient(tt.opts...)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, client)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedBaseURL, client.(*Call).baseURL)
+		})
+	}
+}
+
+func TestNewClientOptions(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		assert.Equal(t, "payments-worker/1.0", r.Header.Get("User-Agent"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(`{"data":{"USD":10}}`))
+		assert.NoError(t, err)
+	}))
+	defer ts.Close()
+
+	logger := zerolog.Nop()
+	httpClient := &http.Client{}
+	client, err := NewClient(
+		WithCredentials("secret", "token"),
+		WithBaseURL(ts.URL+"/"),
+		WithHTTPClient(httpClient),
+		WithTimeout(5*time.Second),
+		WithUserAgent("payments-worker/1.0"),
+		WithLogger(&logger),
+		WithRetries(NoRetryPolicy),
+	)
+	assert.NoError(t, err)
+
+	call := client.(*Call)
+	assert.Equal(t, NoRetryPolicy, call.retryPolicy)
+	assert.Equal(t, 5*time.Second, call.timeout)
+	assert.Zero(t, httpClient.Timeout)
+
+	balances, err := client.GetBalances(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]float64{"USD": 10}, balances)
+}
+
+func TestNewIsCompatible(t *testing.T) {
+	logger := zerolog.Nop()
+	client := resty.New()
+
+	call := New(&logger, client, "secret", "token", "http://localhost/").(*Call)
+	assert.Equal(t, "http://localhost/", call.baseURL)
+	credentials, err := call.credentials.Credentials(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, Credentials{APISecret: "secret", BearerToken: "token"}, credentials)
+	assert.Same(t, client, call.client)
+	assert.Equal(t, NoRetryPolicy, call.retryPolicy)
+	assert.Equal(t, TimeoutPolicy{}, call.timeoutPolicy)
+
+	call = New(&logger, client, "secret", "token", "http://localhost/",
+		WithRetries(DefaultRetryPolicy), WithTimeoutPolicy(DefaultTimeoutPolicy)).(*Call)
+	assert.Equal(t, DefaultRetryPolicy, call.retryPolicy)
+	assert.Equal(t, DefaultTimeoutPolicy, call.timeoutPolicy)
+}
+
+func TestTimeoutDoesNotTouchSharedClient(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"USD":10}}`))
+	}))
+	defer ts.Close()
+
+	shared := resty.New()
+	fast, err := NewClient(
+		WithCredentials("secret", "token"),
+		WithBaseURL(ts.URL+"/"),
+		WithRestyClient(shared),
+		WithTimeout(10*time.Millisecond),
+		WithRetries(NoRetryPolicy),
+	)
+	assert.NoError(t, err)
+	patient, err := NewClient(WithCredentials("secret", "token"), WithBaseURL(ts.URL+"/"), WithRestyClient(shared))
+	assert.NoError(t, err)
+
+	_, err = fast.GetBalances(context.Background())
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	_, err = patient.GetBalances(context.Background())
+	assert.NoError(t, err)
+	assert.Zero(t, shared.GetClient().Timeout)
+}
@@ -16,10 +16,10 @@ func (c *Call) InitiatePaymentCardRequest(ctx context.Context, request model.Ini
 		err       error
 		response  string
 		path      = "v1/payments/cards/initiate"
-		signature = helpers.GetSignatureFromReferenceAndPubKey(request.Reference, c.apiSecret)
+		reference = request.Reference
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodPost, &signature, nil, nil, request, &response)
+	err = c.makeRequest(ctx, "InitiatePaymentCardRequest", path, http.MethodPost, &reference, nil, nil, request, &response)
 
 	return response, err
 }
@@ -31,7 +31,7 @@ func (c *Call) CompletePaymentCardRequest(ctx context.Context, request model.Com
 		path = "v1/payments/cards/complete"
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodPost, nil, nil, nil, request, nil)
+	err = c.makeRequest(ctx, "CompletePaymentCardRequest", path, http.MethodPost, nil, nil, nil, request, nil)
 
 	return err
 }
@@ -44,7 +44,7 @@ func (c *Call) GetLinkToAddPaymentCard(ctx context.Context, request model.GetLin
 		path     = "v1/payments/cards"
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodPost, nil, nil, nil, request, &response)
+	err = c.makeRequest(ctx, "GetLinkToAddPaymentCard", path, http.MethodPost, nil, nil, nil, request, &response)
 
 	return response, err
 }
@@ -57,13 +57,18 @@ func (c *Call) GetLinkToAuthorizeCustomer(ctx context.Context, request model.Get
 		path     = "v1/payments/cards/authorize"
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodPost, nil, nil, nil, request, &response)
+	err = c.makeRequest(ctx, "GetLinkToAuthorizeCustomer", path, http.MethodPost, nil, nil, nil, request, &response)
 
 	return response, err
 }
 
-// GetCustomerPaymentCards makes request to Torus to get al payment cards for a customer
-func (c *Call) GetCustomerPaymentCards(ctx context.Context, customerID string, status, search *string, dateBetween *model.DateBetween, page *model.Page) (model.AllPaymentCardsResponse, error) {
+// ListCustomerPaymentCards makes request to Torus to get the payment cards of a customer matching the filter
+func (c *Call) ListCustomerPaymentCards(ctx context.Context, customerID string, filter model.PaymentCardFilter, page *model.Page) (model.AllPaymentCardsResponse, error) {
+	return c.listCustomerPaymentCards(ctx, "ListCustomerPaymentCards", customerID, filter, page)
+}
+
+// listCustomerPaymentCards backs ListCustomerPaymentCards and the deprecated GetCustomerPaymentCards, reported as operation
+func (c *Call) listCustomerPaymentCards(ctx context.Context, operation string, customerID string, filter model.PaymentCardFilter, page *model.Page) (model.AllPaymentCardsResponse, error) {
 	var (
 		err      error
 		response model.AllPaymentCardsResponse
@@ -71,24 +76,32 @@ func (c *Call) GetCustomerPaymentCards(ctx context.Context, customerID string, s
 		path     = fmt.Sprintf("v1/payments/cards/%s", customerID)
 	)
 
-	if status != nil {
-		params["status"] = *status
-	}
-	if search != nil {
-		params["search"] = *search
-	}
-	if dateBetween != nil {
-		helpers.FillParamsWithDateInterval(params, *dateBetween)
+	if err = helpers.FillParamsWithFilter(params, filter); err != nil {
+		return response, err
 	}
 	if page != nil {
 		helpers.FillParamsWithPage(params, *page)
 	}
 
-	err = c.makeRequest(ctx, path, http.MethodGet, nil, params, nil, nil, &response)
+	err = c.makeRequest(ctx, operation, path, http.MethodGet, nil, params, nil, nil, &response)
 
 	return response, err
 }
 
+// GetCustomerPaymentCards makes request to Torus to get al payment cards for a customer
+//
+// Deprecated: use ListCustomerPaymentCards, which takes a PaymentCardFilter
+func (c *Call) GetCustomerPaymentCards(ctx context.Context, customerID string, status, search *string, dateBetween *model.DateBetween, page *model.Page) (model.AllPaymentCardsResponse, error) {
+	filter := model.PaymentCardFilter{Dates: dateBetween}
+	if status != nil {
+		filter.Status = *status
+	}
+	if search != nil {
+		filter.Search = *search
+	}
+	return c.listCustomerPaymentCards(ctx, "GetCustomerPaymentCards", customerID, filter, page)
+}
+
 // GetCustomerPaymentCardByID makes request to Torus to get link to authorize customer for payment card by the ID
 func (c *Call) GetCustomerPaymentCardByID(ctx context.Context, customerID, ID string) (model.PaymentCard, error) {
 	var (
@@ -97,7 +110,7 @@ func (c *Call) GetCustomerPaymentCardByID(ctx context.Context, customerID, ID st
 		path     = fmt.Sprintf("v1/payments/cards/%s/%s", customerID, ID)
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodGet, nil, nil, nil, nil, &response)
+	err = c.makeRequest(ctx, "GetCustomerPaymentCardByID", path, http.MethodGet, nil, nil, nil, nil, &response)
 
 	return response, err
 }
@@ -108,10 +121,10 @@ func (c *Call) DebitPaymentCard(ctx context.Context, request model.DebitCustomer
 		err       error
 		response  model.Deposit
 		path      = "v1/payments/cards/debit"
-		signature = helpers.GetSignatureFromReferenceAndPubKey(request.Reference, c.apiSecret)
+		reference = request.Reference
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodPost, &signature, nil, nil, request, &response)
+	err = c.makeRequest(ctx, "DebitPaymentCard", path, http.MethodPost, &reference, nil, nil, request, &response)
 
 	return response, err
 }
@@ -120,7 +133,7 @@ func (c *Call) DebitPaymentCard(ctx context.Context, request model.DebitCustomer
 func (c *Call) RefundCustomerDeposit(ctx context.Context, request model.RefundCustomerDepositRequest) error {
 	path := "v1/payments/refund"
 
-	err := c.makeRequest(ctx, path, http.MethodPost, nil, nil, nil, request, nil)
+	err := c.makeRequest(ctx, "RefundCustomerDeposit", path, http.MethodPost, nil, nil, nil, request, nil)
 	return err
 }
 
@@ -128,6 +141,6 @@ func (c *Call) RefundCustomerDeposit(ctx context.Context, request model.RefundCu
 func (c *Call) DeleteCustomerPaymentCard(ctx context.Context, customerID, cardID string) error {
 	path := fmt.Sprintf("v1/payments/cards/%s/%s", customerID, cardID)
 
-	err := c.makeRequest(ctx, path, http.MethodDelete, nil, nil, nil, nil, nil)
+	err := c.makeRequest(ctx, "DeleteCustomerPaymentCard", path, http.MethodDelete, nil, nil, nil, nil, nil)
 	return err
 }
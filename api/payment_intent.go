@@ -16,7 +16,7 @@ func (c *Call) CreateCustomerPaymentIntent(ctx context.Context, request model.Cr
 		path     = "v1/payments/intents"
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodPost, nil, nil, nil, request, &response)
+	err = c.makeRequest(ctx, "CreateCustomerPaymentIntent", path, http.MethodPost, nil, nil, nil, request, &response)
 
 	return response, err
 }
@@ -29,7 +29,7 @@ func (c *Call) CompleteCustomerPaymentIntent(ctx context.Context, request model.
 		path     = "v1/payments/intents/complete"
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodPost, nil, nil, nil, request, &response)
+	err = c.makeRequest(ctx, "CompleteCustomerPaymentIntent", path, http.MethodPost, nil, nil, nil, request, &response)
 
 	return response, err
 }
@@ -42,7 +42,7 @@ func (c *Call) AuthenticateCustomerPaymentIntent(ctx context.Context, request mo
 		path     = "v1/payments/intents/authenticate"
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodPost, nil, nil, nil, request, &response)
+	err = c.makeRequest(ctx, "AuthenticateCustomerPaymentIntent", path, http.MethodPost, nil, nil, nil, request, &response)
 
 	return response, err
 }
@@ -55,7 +55,7 @@ func (c *Call) GetCustomerPaymentIntentByID(ctx context.Context, paymentIntentID
 		path     = fmt.Sprintf("v1/payments/intents/%s", paymentIntentID)
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodGet, nil, nil, nil, nil, &response)
+	err = c.makeRequest(ctx, "GetCustomerPaymentIntentByID", path, http.MethodGet, nil, nil, nil, nil, &response)
 
 	return response, err
 }
@@ -20,7 +20,7 @@ func (c *Call) GetPayoutByID(ctx context.Context, payoutID string) (model.Payout
 		path     = fmt.Sprintf("%s/%s", payoutAPIVersion, payoutID)
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodGet, nil, nil, nil, nil, &response)
+	err = c.makeRequest(ctx, "GetPayoutByID", path, http.MethodGet, nil, nil, nil, nil, &response)
 
 	return response, err
 }
@@ -33,13 +33,30 @@ func (c *Call) InitiateDirectBulkPayout(ctx context.Context, request model.Initi
 		path     = payoutAPIVersion
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodPost, nil, nil, nil, request, &response)
+	err = c.makeRequest(ctx, "InitiateDirectBulkPayout", path, http.MethodPost, nil, nil, nil, request, &response)
 
 	return response, err
 }
 
 // InitiatePayout makes a request to Torus to initiate a bulk payout
+//
+// Deprecated: use InitiatePayoutUpload, which streams the document from any io.Reader
 func (c *Call) InitiatePayout(ctx context.Context, currency, payoutType, beneficiaryType, remarks string, customerID *string, document *os.File) (model.PayoutDetails, error) {
+	upload, err := model.NewFileUpload(document)
+	if err != nil {
+		return model.PayoutDetails{}, err
+	}
+
+	return c.initiatePayoutUpload(ctx, "InitiatePayout", currency, payoutType, beneficiaryType, remarks, customerID, upload)
+}
+
+// InitiatePayoutUpload makes a request to Torus to initiate a bulk payout, streaming the payout document
+func (c *Call) InitiatePayoutUpload(ctx context.Context, currency, payoutType, beneficiaryType, remarks string, customerID *string, document model.Upload) (model.PayoutDetails, error) {
+	return c.initiatePayoutUpload(ctx, "InitiatePayoutUpload", currency, payoutType, beneficiaryType, remarks, customerID, document)
+}
+
+// initiatePayoutUpload backs InitiatePayoutUpload and the deprecated InitiatePayout, reported as operation
+func (c *Call) initiatePayoutUpload(ctx context.Context, operation string, currency, payoutType, beneficiaryType, remarks string, customerID *string, document model.Upload) (model.PayoutDetails, error) {
 	var (
 		err      error
 		response model.PayoutDetails
@@ -54,15 +71,25 @@ func (c *Call) InitiatePayout(ctx context.Context, currency, payoutType, benefic
 		formData["customer_id"] = *customerID
 	}
 	formData["remarks"] = remarks
-	formData["document"] = document
+	// the request ID is set here so that upload failures carry it
+	ctx = withRequestID(ctx)
+	formData["document"], err = c.prepareUpload(ctx, "document", document)
+	if err != nil {
+		return response, err
+	}
 
-	err = c.makeRequest(ctx, path, http.MethodPost, nil, nil, formData, nil, &response)
+	err = c.makeRequest(ctx, operation, path, http.MethodPost, nil, nil, formData, nil, &response)
 
 	return response, err
 }
 
-// GetAllPayouts makes request to Torus to get all payouts
-func (c *Call) GetAllPayouts(ctx context.Context, status, search string, dateBetween model.DateBetween, page model.Page) (model.AllPayoutsResponse, error) {
+// ListPayouts makes request to Torus to get the payouts matching the filter
+func (c *Call) ListPayouts(ctx context.Context, filter model.PayoutFilter, page *model.Page) (model.AllPayoutsResponse, error) {
+	return c.listPayouts(ctx, "ListPayouts", filter, page)
+}
+
+// listPayouts backs ListPayouts and the deprecated GetAllPayouts, reported as operation
+func (c *Call) listPayouts(ctx context.Context, operation string, filter model.PayoutFilter, page *model.Page) (model.AllPayoutsResponse, error) {
 	var (
 		err      error
 		response model.AllPayoutsResponse
@@ -70,24 +97,29 @@ func (c *Call) GetAllPayouts(ctx context.Context, status, search string, dateBet
 		path     = payoutAPIVersion
 	)
 
-	if status != "" {
-		params["status"] = status
-	}
-	if search != "" {
-		params["search"] = search
+	if err = helpers.FillParamsWithFilter(params, filter); err != nil {
+		return response, err
 	}
-	if dateBetween != (model.DateBetween{}) {
-		helpers.FillParamsWithDateInterval(params, dateBetween)
-	}
-	if page != (model.Page{}) {
-		helpers.FillParamsWithPage(params, page)
+	if page != nil {
+		helpers.FillParamsWithPage(params, *page)
 	}
 
-	err = c.makeRequest(ctx, path, http.MethodGet, nil, params, nil, nil, &response)
+	err = c.makeRequest(ctx, operation, path, http.MethodGet, nil, params, nil, nil, &response)
 
 	return response, err
 }
 
+// GetAllPayouts makes request to Torus to get all payouts
+//
+// Deprecated: use ListPayouts, which takes a PayoutFilter
+func (c *Call) GetAllPayouts(ctx context.Context, status, search string, dateBetween model.DateBetween, page model.Page) (model.AllPayoutsResponse, error) {
+	filter := model.PayoutFilter{Status: status, Search: search}
+	if dateBetween != (model.DateBetween{}) {
+		filter.Dates = dateBetween
+	}
+	return c.listPayouts(ctx, "GetAllPayouts", filter, &page)
+}
+
 // CancelPayout makes request to Torus to cancel payout
 func (c *Call) CancelPayout(ctx context.Context, request model.CancelPayoutRequest) error {
 	var (
@@ -95,7 +127,7 @@ func (c *Call) CancelPayout(ctx context.Context, request model.CancelPayoutReque
 		path = fmt.Sprintf("%s/cancel", payoutAPIVersion)
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodPost, nil, nil, nil, request, nil)
+	err = c.makeRequest(ctx, "CancelPayout", path, http.MethodPost, nil, nil, nil, request, nil)
 
 	return err
 }
@@ -107,7 +139,7 @@ func (c *Call) UpdatePayoutAccount(ctx context.Context, payoutID string, request
 		path = fmt.Sprintf("%s/accounts/%s", payoutAPIVersion, payoutID)
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodPut, nil, nil, nil, request, nil)
+	err = c.makeRequest(ctx, "UpdatePayoutAccount", path, http.MethodPut, nil, nil, nil, request, nil)
 
 	return err
 }
@@ -120,7 +152,7 @@ func (c *Call) GetPayoutConfig(ctx context.Context, currency string) (model.Bulk
 		path     = fmt.Sprintf("%s/config/%s", payoutAPIVersion, currency)
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodGet, nil, nil, nil, nil, &response)
+	err = c.makeRequest(ctx, "GetPayoutConfig", path, http.MethodGet, nil, nil, nil, nil, &response)
 
 	return response, err
 }
@@ -141,7 +173,7 @@ func (c *Call) GetPayoutDocumentTemplate(ctx context.Context, currency, docType
 		params["type"] = docType
 	}
 
-	err = c.makeRequest(ctx, path, http.MethodGet, nil, params, nil, nil, &response)
+	err = c.makeRequest(ctx, "GetPayoutDocumentTemplate", path, http.MethodGet, nil, params, nil, nil, &response)
 
 	return response, err
 }
@@ -0,0 +1,125 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+
+	"github.com/ovalfi/go-sdk/model"
+)
+
+var (
+	// ErrUnknownBusiness is returned when a ClientPool has no client for the requested business
+	ErrUnknownBusiness = errors.New("unknown business")
+
+	// ErrDuplicateBusiness is returned when registering a business twice in a ClientPool
+	ErrDuplicateBusiness = errors.New("business already registered")
+)
+
+// ClientPool holds one client per Oval business. The clients share the options of the pool and a single HTTP transport,
+// and tag their logs and metrics with the ID of their business
+type ClientPool struct {
+	options   []Option
+	transport *http.Transport
+
+	mu      sync.RWMutex
+	clients map[string]RemoteCalls
+}
+
+// NewClientPool returns an empty pool whose clients are built with the given options, followed by the options of each business.
+// The clients share the transport of the pool unless the options set their own HTTP client
+func NewClientPool(opts ...Option) *ClientPool {
+	return &ClientPool{
+		options:   opts,
+		transport: http.DefaultTransport.(*http.Transport).Clone(),
+		clients:   make(map[string]RemoteCalls),
+	}
+}
+
+// WithBusinessID returns a context selecting the business whose client ClientPool.FromContext hands out
+func WithBusinessID(ctx context.Context, businessID string) context.Context {
+	return context.WithValue(ctx, model.BusinessIDContextKey, businessID)
+}
+
+// BusinessIDFromContext returns the business selected by WithBusinessID, or an empty string
+func BusinessIDFromContext(ctx context.Context) string {
+	businessID, _ := ctx.Value(model.BusinessIDContextKey).(string)
+	return businessID
+}
+
+// Register builds the client of a business, typically with its credentials, e.g.
+//
+//	pool.Register("eu-business-id", api.WithCredentials(euSecret, euToken))
+func (p *ClientPool) Register(businessID string, opts ...Option) (RemoteCalls, error) {
+	options := make([]Option, 0, len(p.options)+len(opts)+2)
+	options = append(options, WithHTTPClient(&http.Client{Transport: p.transport}))
+	options = append(options, p.options...)
+	options = append(options, opts...)
+	options = append(options, withBusinessID(businessID))
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, ok := p.clients[businessID]; ok {
+		return nil, fmt.Errorf("%s: %w", businessID, ErrDuplicateBusiness)
+	}
+
+	client, err := NewClient(options...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", businessID, err)
+	}
+	p.clients[businessID] = client
+	return client, nil
+}
+
+// Get returns the client of a business
+func (p *ClientPool) Get(businessID string) (RemoteCalls, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	client, ok := p.clients[businessID]
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", businessID, ErrUnknownBusiness)
+	}
+	return client, nil
+}
+
+// FromContext returns the client of the business selected by WithBusinessID
+func (p *ClientPool) FromContext(ctx context.Context) (RemoteCalls, error) {
+	return p.Get(BusinessIDFromContext(ctx))
+}
+
+// Remove drops the client of a business
+func (p *ClientPool) Remove(businessID string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.clients, businessID)
+}
+
+// BusinessIDs returns the registered businesses, sorted
+func (p *ClientPool) BusinessIDs() []string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	businessIDs := make([]string, 0, len(p.clients))
+	for businessID := range p.clients {
+		businessIDs = append(businessIDs, businessID)
+	}
+	sort.Strings(businessIDs)
+	return businessIDs
+}
+
+// Close closes the idle connections of the shared transport
+func (p *ClientPool) Close() {
+	p.transport.CloseIdleConnections()
+}
+
+// withBusinessID tags the logs, metrics and spans of the client with the business it belongs to
+func withBusinessID(businessID string) Option {
+	return func(o *options) {
+		o.businessID = businessID
+	}
+}
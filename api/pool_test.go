@@ -0,0 +1,87 @@
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ovalfi/go-sdk/model"
+)
+
+// observations records the observations of the requests sent by a client
+type observations struct {
+	noopMetrics
+	mu   sync.Mutex
+	list []RequestObservation
+}
+
+func (o *observations) ObserveRequest(observation RequestObservation) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.list = append(o.list, observation)
+}
+
+func TestClientPool(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"data":{"account_name":"` + r.Header.Get("Authorization") + `"}}`))
+	}))
+	defer ts.Close()
+
+	var logs bytes.Buffer
+	logger := zerolog.New(&logs)
+	recorder := &observations{}
+
+	pool := NewClientPool(WithBaseURL(ts.URL+"/"), WithLogger(&logger), WithMetrics(recorder))
+	defer pool.Close()
+
+	eu, err := pool.Register("business-eu", WithCredentials("secret-eu", "token-eu"))
+	require.NoError(t, err)
+	_, err = pool.Register("business-ng", WithCredentials("secret-ng", "token-ng"))
+	require.NoError(t, err)
+
+	_, err = pool.Register("business-eu", WithCredentials("secret", "token"))
+	assert.ErrorIs(t, err, ErrDuplicateBusiness)
+	_, err = pool.Register("business-us")
+	assert.ErrorIs(t, err, ErrMissingCredentials)
+	assert.Equal(t, []string{"business-eu", "business-ng"}, pool.BusinessIDs())
+
+	client, err := pool.FromContext(WithBusinessID(context.Background(), "business-ng"))
+	require.NoError(t, err)
+	account, err := client.ResolveBankAccount(context.Background(), model.AccountResolveRequest{BankCode: "058"})
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer token-ng", account.AccountName)
+
+	client, err = pool.Get("business-eu")
+	require.NoError(t, err)
+	assert.Same(t, eu, client)
+	assert.Same(t, pool.transport, eu.(*Call).client.GetClient().Transport)
+	assert.Same(t, pool.transport, client.(*Call).client.GetClient().Transport)
+
+	require.Len(t, recorder.list, 1)
+	assert.Equal(t, "business-ng", recorder.list[0].BusinessID)
+
+	lines := bufio.NewScanner(&logs)
+	for lines.Scan() {
+		var line map[string]interface{}
+		require.NoError(t, json.Unmarshal(lines.Bytes(), &line))
+		assert.Equal(t, "business-ng", line[model.LogBusinessID])
+	}
+
+	_, err = pool.FromContext(context.Background())
+	assert.ErrorIs(t, err, ErrUnknownBusiness)
+
+	pool.Remove("business-eu")
+	_, err = pool.Get("business-eu")
+	assert.ErrorIs(t, err, ErrUnknownBusiness)
+}
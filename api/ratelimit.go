@@ -0,0 +1,87 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+type (
+	// RateLimit limits the requests sent for an endpoint group
+	RateLimit struct {
+		// RequestsPerSecond is the sustained rate of the token bucket. Zero means no rate limit
+		RequestsPerSecond float64
+		// Burst is the size of the token bucket. Defaults to 1 when a rate is set
+		Burst int
+		// MaxInFlight caps the number of requests waiting for a response at the same time. Zero means no limit
+		MaxInFlight int
+	}
+
+	// limiter enforces a RateLimit
+	limiter struct {
+		bucket *rate.Limiter
+		slots  chan struct{}
+	}
+)
+
+// WithRateLimit limits the requests sent for an endpoint group. Calls block until they are allowed or their context is done
+func WithRateLimit(group EndpointGroup, limit RateLimit) Option {
+	return func(o *options) {
+		if o.rateLimits == nil {
+			o.rateLimits = make(map[EndpointGroup]RateLimit)
+		}
+		o.rateLimits[group] = limit
+	}
+}
+
+// newLimiters builds the limiters of the configured endpoint groups
+func newLimiters(limits map[EndpointGroup]RateLimit) map[EndpointGroup]*limiter {
+	limiters := make(map[EndpointGroup]*limiter, len(limits))
+	for group, limit := range limits {
+		l := &limiter{}
+		if limit.RequestsPerSecond > 0 {
+			burst := limit.Burst
+			if burst < 1 {
+				burst = 1
+			}
+			l.bucket = rate.NewLimiter(rate.Limit(limit.RequestsPerSecond), burst)
+		}
+		if limit.MaxInFlight > 0 {
+			l.slots = make(chan struct{}, limit.MaxInFlight)
+		}
+		limiters[group] = l
+	}
+	return limiters
+}
+
+// acquire waits until a request of the group may be sent. It returns the time spent waiting
+// and a function that must be called once the response is received
+func (c *Call) acquire(ctx context.Context, group EndpointGroup) (time.Duration, func(), error) {
+	l, ok := c.limiters[group]
+	if !ok {
+		return 0, func() {}, nil
+	}
+
+	start := time.Now()
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+		case <-ctx.Done():
+			return time.Since(start), nil, ctx.Err()
+		}
+	}
+	release := func() {
+		if l.slots != nil {
+			<-l.slots
+		}
+	}
+
+	if l.bucket != nil {
+		if err := l.bucket.Wait(ctx); err != nil {
+			release()
+			return time.Since(start), nil, err
+		}
+	}
+	return time.Since(start), release, nil
+}
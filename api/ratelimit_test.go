@@ -0,0 +1,126 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ovalfi/go-sdk/model"
+)
+
+func TestRateLimitMaxInFlight(t *testing.T) {
+	var inFlight, peak int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		for {
+			old := atomic.LoadInt32(&peak)
+			if current <= old || atomic.CompareAndSwapInt32(&peak, old, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"data":{"account_name":"Ada"}}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(
+		WithCredentials("secret", "token"),
+		WithBaseURL(ts.URL+"/"),
+		WithRateLimit(GroupReferenceData, RateLimit{MaxInFlight: 2}),
+	)
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.ResolveBankAccount(context.Background(), model.AccountResolveRequest{BankCode: "058", AccountNumber: "0123456789"})
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(2), atomic.LoadInt32(&peak))
+}
+
+func TestRateLimitTokenBucket(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if r.URL.Path == "/"+bankAPIVersion {
+			_, _ = w.Write([]byte(`{"data":[]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"USD":1}}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(
+		WithCredentials("secret", "token"),
+		WithBaseURL(ts.URL+"/"),
+		WithRateLimit(GroupPayments, RateLimit{RequestsPerSecond: 20, Burst: 1}),
+	)
+	require.NoError(t, err)
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		_, err = client.GetBalances(context.Background())
+		require.NoError(t, err)
+	}
+	assert.GreaterOrEqual(t, time.Since(start), 180*time.Millisecond)
+
+	// other groups are not limited
+	start = time.Now()
+	for i := 0; i < 5; i++ {
+		_, err = client.GetBanks(context.Background())
+		require.NoError(t, err)
+	}
+	assert.Less(t, time.Since(start), 100*time.Millisecond)
+}
+
+func TestRateLimitContextDone(t *testing.T) {
+	var calls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(
+		WithCredentials("secret", "token"),
+		WithBaseURL(ts.URL+"/"),
+		WithRateLimit(GroupCustomers, RateLimit{RequestsPerSecond: 0.1, Burst: 1}),
+	)
+	require.NoError(t, err)
+
+	_, err = client.GetCustomerBalances(context.Background(), "customer-1")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = client.GetCustomerBalances(ctx, "customer-2")
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestGroupOf(t *testing.T) {
+	assert.Equal(t, GroupBills, groupOf("PayBill"))
+	assert.Equal(t, GroupKYC, groupOf("SubmitCustomerKYCDocument"))
+	assert.Equal(t, GroupReferenceData, groupOf("ResolveBankAccount"))
+	assert.Equal(t, GroupCustomers, groupOf("GetCustomerBalances"))
+	assert.Equal(t, GroupPayments, groupOf("InitiateDirectBulkPayout"))
+}
@@ -13,5 +13,5 @@ const reportAPIVersion = "v1/transaction-reporting"
 func (c *Call) SubmitSTR(ctx context.Context, request model.SubmitSTRRequest) error {
 	path := reportAPIVersion + "/str"
 
-	return c.makeRequest(ctx, path, http.MethodPost, nil, nil, nil, request, nil)
+	return c.makeRequest(ctx, "SubmitSTR", path, http.MethodPost, nil, nil, nil, request, nil)
 }
@@ -0,0 +1,195 @@
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ovalfi/go-sdk/helpers"
+	"github.com/ovalfi/go-sdk/model"
+)
+
+func TestRequestIDGenerated(t *testing.T) {
+	var sent []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sent = append(sent, r.Header.Get(model.RequestIDHeaderKey))
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"data":{"account_name":"Ada"}}`))
+	}))
+	defer ts.Close()
+
+	var logs bytes.Buffer
+	c := &Call{baseURL: ts.URL + "/", client: resty.New(), logger: zerolog.New(&logs), credentials: StaticCredentials("", "test-token")}
+
+	request := model.AccountResolveRequest{BankCode: "058", AccountNumber: "0123456789"}
+	_, err := c.ResolveBankAccount(context.Background(), request)
+	require.NoError(t, err)
+	_, err = c.ResolveBankAccount(context.Background(), request)
+	require.NoError(t, err)
+
+	require.Len(t, sent, 2)
+	_, err = uuid.Parse(sent[0])
+	assert.NoError(t, err)
+	assert.NotEqual(t, sent[0], sent[1])
+
+	lines := bufio.NewScanner(&logs)
+	for lines.Scan() {
+		var line map[string]interface{}
+		require.NoError(t, json.Unmarshal(lines.Bytes(), &line))
+		assert.Contains(t, sent, line[model.LogRequestID], "log line without request id: %s", lines.Text())
+	}
+}
+
+func TestRequestIDFromContext(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "req-42", r.Header.Get(model.RequestIDHeaderKey))
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	defer ts.Close()
+
+	_, err := newTestCall(ts.URL).ResolveBankAccount(helpers.WithRequestID(context.Background(), "req-42"), model.AccountResolveRequest{})
+	assert.NoError(t, err)
+}
+
+func TestRequestIDOnErrors(t *testing.T) {
+	tests := map[string]struct {
+		echoed   string
+		expected string
+	}{
+		"echoed by the api": {
+			echoed:   "srv-7",
+			expected: "srv-7",
+		},
+		"not echoed": {
+			expected: "req-42",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tt.echoed != "" {
+					w.Header().Set(model.RequestIDHeaderKey, tt.echoed)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"error":{"id":"not_found","details":"customer not found"}}`))
+			}))
+			defer ts.Close()
+
+			_, err := newTestCall(ts.URL).GetCustomerByID(helpers.WithRequestID(context.Background(), "req-42"), "cus-1")
+			assert.ErrorIs(t, err, model.ErrNotFound)
+			assert.Equal(t, tt.expected, model.RequestIDOf(err))
+		})
+	}
+
+	c := newTestCall("http://127.0.0.1:1")
+	c.retryPolicy = NoRetryPolicy
+	_, err := c.GetCustomerByID(helpers.WithRequestID(context.Background(), "req-43"), "cus-1")
+	assert.ErrorIs(t, err, model.ErrNetworkError)
+	assert.Equal(t, "req-43", model.RequestIDOf(err))
+}
+
+func TestRequestIDOnClientErrors(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("fail") != "" {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"error":{"details":"unavailable"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":"not an object"}`))
+	}))
+	defer ts.Close()
+
+	tests := map[string]struct {
+		setup    func(c *Call)
+		call     func(ctx context.Context, c *Call) error
+		expected error
+	}{
+		"decoding": {
+			call: func(ctx context.Context, c *Call) error {
+				_, err := c.ResolveBankAccount(ctx, model.AccountResolveRequest{})
+				return err
+			},
+		},
+		"credentials": {
+			setup: func(c *Call) {
+				c.credentials = EnvCredentials("OVALFI_TEST_UNSET_SECRET", "OVALFI_TEST_UNSET_TOKEN")
+			},
+			call: func(ctx context.Context, c *Call) error {
+				_, err := c.ResolveBankAccount(ctx, model.AccountResolveRequest{})
+				return err
+			},
+			expected: ErrMissingCredentials,
+		},
+		"circuit open": {
+			setup: func(c *Call) {
+				c.breakerSettings = &CircuitBreakerSettings{FailureRatio: 0.5, MinRequests: 1, Window: time.Minute, CoolDown: time.Minute}
+				_ = c.makeRequest(context.Background(), "ResolveBankAccount", bankAPIVersion+"/resolve-account", http.MethodGet,
+					nil, map[string]interface{}{"fail": "1"}, nil, nil, nil)
+			},
+			call: func(ctx context.Context, c *Call) error {
+				_, err := c.ResolveBankAccount(ctx, model.AccountResolveRequest{})
+				return err
+			},
+			expected: ErrCircuitOpen,
+		},
+		"rate limit": {
+			setup: func(c *Call) {
+				c.limiters = newLimiters(map[EndpointGroup]RateLimit{groupOf("ResolveBankAccount"): {RequestsPerSecond: 0.001}})
+				_, _ = c.ResolveBankAccount(context.Background(), model.AccountResolveRequest{})
+			},
+			call: func(ctx context.Context, c *Call) error {
+				ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+				defer cancel()
+				_, err := c.ResolveBankAccount(ctx, model.AccountResolveRequest{})
+				return err
+			},
+		},
+		"upload": {
+			call: func(ctx context.Context, c *Call) error {
+				_, err := c.InitiatePayoutUpload(ctx, "USD", "bank", "individual", "", nil, model.Upload{})
+				return err
+			},
+			expected: model.ErrValidation,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := newTestCall(ts.URL)
+			if tt.setup != nil {
+				tt.setup(c)
+			}
+			var logs bytes.Buffer
+			c.logger = zerolog.New(&logs)
+
+			err := tt.call(helpers.WithRequestID(context.Background(), "req-44"), c)
+			require.Error(t, err)
+			if tt.expected != nil {
+				assert.ErrorIs(t, err, tt.expected)
+			}
+			assert.Equal(t, "req-44", model.RequestIDOf(err))
+
+			lines := bufio.NewScanner(&logs)
+			for lines.Scan() {
+				var line map[string]interface{}
+				require.NoError(t, json.Unmarshal(lines.Bytes(), &line))
+				assert.Equal(t, "req-44", line[model.LogRequestID], "log line without request id: %s", lines.Text())
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+
+	"github.com/ovalfi/go-sdk/model"
+)
+
+// ResponseInfo is the metadata of the response to the last attempt of a call, useful for support tickets
+type ResponseInfo struct {
+	// StatusCode is the HTTP status code of the response, 0 when no response was received
+	StatusCode int
+	// Header holds the response headers, including the rate limit headers sent by the API
+	Header http.Header
+	// RequestID is the request ID echoed by the API, or the one sent when the API did not echo it
+	RequestID string
+	// Attempts is the number of HTTP requests sent, including retries
+	Attempts int
+	// Latency is the time spent in the call, including retries and backoff
+	Latency time.Duration
+}
+
+// WithResponseInfo returns a context that fills info with the response metadata of the call made with it,
+// whether the call succeeds or fails. The context must not be shared by concurrent calls
+//
+//	var info api.ResponseInfo
+//	rates, err := apiCalls.GetExchangeRates(api.WithResponseInfo(ctx, &info), request)
+//	log.Println(info.StatusCode, info.RequestID)
+func WithResponseInfo(ctx context.Context, info *ResponseInfo) context.Context {
+	return context.WithValue(ctx, model.ResponseInfoContextKey, info)
+}
+
+// responseInfoFrom returns the collector carried by the context, if any
+func responseInfoFrom(ctx context.Context) *ResponseInfo {
+	info, _ := ctx.Value(model.ResponseInfoContextKey).(*ResponseInfo)
+	return info
+}
+
+// record fills the collector with the outcome of a call
+func (i *ResponseInfo) record(res *resty.Response, requestID string, attempts int, latency time.Duration) {
+	*i = ResponseInfo{
+		RequestID: requestID,
+		Attempts:  attempts,
+		Latency:   latency,
+	}
+
+	if res == nil || res.RawResponse == nil {
+		return
+	}
+
+	i.StatusCode = res.StatusCode()
+	i.Header = res.Header().Clone()
+	if echoed := res.Header().Get(model.RequestIDHeaderKey); echoed != "" {
+		i.RequestID = echoed
+	}
+}
@@ -0,0 +1,78 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ovalfi/go-sdk/model"
+)
+
+func TestResponseInfoSuccess(t *testing.T) {
+	var calls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set(model.RequestIDHeaderKey, "srv-123")
+		w.Header().Set("X-RateLimit-Remaining", "41")
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"data":{"account_name":"Ada"}}`))
+	}))
+	defer ts.Close()
+
+	c := newTestCall(ts.URL)
+	c.retryPolicy = RetryPolicy{MaxAttempts: 2}
+
+	var info ResponseInfo
+	ctx := WithResponseInfo(WithIdempotencyKey(context.Background(), "resolve-1"), &info)
+	_, err := c.ResolveBankAccount(ctx, model.AccountResolveRequest{BankCode: "058", AccountNumber: "0123456789"})
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, info.StatusCode)
+	assert.Equal(t, "srv-123", info.RequestID)
+	assert.Equal(t, "41", info.Header.Get("X-RateLimit-Remaining"))
+	assert.Equal(t, 2, info.Attempts)
+	assert.True(t, info.Latency > 0)
+}
+
+func TestResponseInfoError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":{"id":"not_found","details":"customer not found"}}`))
+	}))
+	defer ts.Close()
+
+	c := newTestCall(ts.URL)
+
+	var info ResponseInfo
+	ctx := context.WithValue(context.Background(), model.RequestIDContextKey, "req-42")
+	ctx = WithResponseInfo(ctx, &info)
+	_, err := c.GetCustomerByID(ctx, "cus-1")
+	assert.ErrorIs(t, err, model.ErrNotFound)
+
+	assert.Equal(t, http.StatusNotFound, info.StatusCode)
+	assert.Equal(t, "req-42", info.RequestID)
+	assert.Equal(t, 1, info.Attempts)
+}
+
+func TestResponseInfoNoResponse(t *testing.T) {
+	c := newTestCall("http://127.0.0.1:1")
+	c.retryPolicy = NoRetryPolicy
+
+	info := ResponseInfo{StatusCode: http.StatusOK}
+	_, err := c.GetCustomerByID(WithResponseInfo(context.Background(), &info), "cus-1")
+	assert.ErrorIs(t, err, model.ErrNetworkError)
+
+	assert.Equal(t, 0, info.StatusCode)
+	assert.Nil(t, info.Header)
+	assert.Equal(t, 1, info.Attempts)
+}
@@ -0,0 +1,144 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+
+	"github.com/ovalfi/go-sdk/model"
+)
+
+// RetryPolicy defines how failed requests are retried
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one. A value below 2 disables retries
+	MaxAttempts int
+	// InitialBackoff is the wait before the first retry
+	InitialBackoff time.Duration
+	// MaxBackoff caps the wait between two attempts
+	MaxBackoff time.Duration
+	// Multiplier is the factor the backoff grows by after every attempt
+	Multiplier float64
+	// Jitter is the fraction, between 0 and 1, of the backoff that is randomised
+	Jitter float64
+}
+
+var (
+	// DefaultRetryPolicy is the retry policy used by clients created with NewClient
+	DefaultRetryPolicy = RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: 200 * time.Millisecond,
+		MaxBackoff:     5 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+	}
+
+	// NoRetryPolicy sends every request exactly once
+	NoRetryPolicy = RetryPolicy{MaxAttempts: 1}
+)
+
+// WithRetryPolicy returns a context that overrides the client retry policy for the calls made with it
+func WithRetryPolicy(ctx context.Context, policy RetryPolicy) context.Context {
+	return context.WithValue(ctx, model.RetryPolicyContextKey, policy)
+}
+
+// SetRetryPolicy sets the retry policy used for every call made by the client
+func (c *Call) SetRetryPolicy(policy RetryPolicy) {
+	c.retryPolicy = policy
+}
+
+// retryPolicyFor returns the retry policy carried by the context, falling back to the client retry policy
+func (c *Call) retryPolicyFor(ctx context.Context) RetryPolicy {
+	if policy, ok := ctx.Value(model.RetryPolicyContextKey).(RetryPolicy); ok {
+		return policy
+	}
+	return c.retryPolicy
+}
+
+// backoff returns how long to wait after the given attempt, honouring the server Retry-After when it asks for longer
+func (p RetryPolicy) backoff(attempt int, retryAfter time.Duration) time.Duration {
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+
+	wait := time.Duration(float64(p.InitialBackoff) * math.Pow(multiplier, float64(attempt-1)))
+	if p.MaxBackoff > 0 && wait > p.MaxBackoff {
+		wait = p.MaxBackoff
+	}
+	if p.Jitter > 0 && wait > 0 {
+		wait -= time.Duration(rand.Float64() * math.Min(p.Jitter, 1) * float64(wait))
+	}
+
+	if retryAfter > wait {
+		return retryAfter
+	}
+	return wait
+}
+
+// isReplayable reports whether a request can safely be sent more than once. POST and PATCH requests are only sent again
+// under a stable idempotency key, since nothing guarantees the API deduplicates the random ones
+func isReplayable(method string, formData map[string]interface{}, stableIdempotencyKey bool) bool {
+	if len(formData) > 0 {
+		// multipart bodies are streamed and cannot be sent again
+		return false
+	}
+
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return stableIdempotencyKey
+	}
+}
+
+// isRetryableError reports whether the error returned by an attempt is worth retrying
+func isRetryableError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) || errors.Is(err, model.ErrNotRetryable) {
+		return false
+	}
+
+	var apiErr *model.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Temporary()
+	}
+
+	return errors.Is(err, model.ErrNetworkError)
+}
+
+// retryAfter parses the Retry-After header of a response, given either in seconds or as an HTTP date
+func retryAfter(res *resty.Response) time.Duration {
+	if res == nil || res.RawResponse == nil {
+		return 0
+	}
+
+	value := res.Header().Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return time.Until(date)
+	}
+	return 0
+}
+
+// sleep waits for the given duration or until the context is done
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,144 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ovalfi/go-sdk/model"
+)
+
+func newRetryTestServer(t *testing.T, failures int32, header http.Header, attempts *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if atomic.AddInt32(attempts, 1) <= failures {
+			for k, v := range header {
+				w.Header()[k] = v
+			}
+			w.WriteHeader(http.StatusBadGateway)
+			_, err := w.Write([]byte(`{"error":{"id":"bad_gateway","details":"bad gateway"}}`))
+			assert.NoError(t, err)
+			return
+		}
+
+		body, err := json.Marshal(model.GenericResponse{Data: rawJSON(t, "ok")})
+		assert.NoError(t, err)
+		w.WriteHeader(http.StatusOK)
+		_, err = w.Write(body)
+		assert.NoError(t, err)
+	}))
+}
+
+func TestMakeRequestRetry(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond, Multiplier: 2}
+
+	tests := map[string]struct {
+		method           string
+		failures         int32
+		ctx              context.Context
+		expectedAttempts int32
+		expectErr        bool
+	}{
+		"GET recovers after transient failures": {
+			method:           http.MethodGet,
+			failures:         2,
+			ctx:              context.Background(),
+			expectedAttempts: 3,
+		},
+		"GET gives up after max attempts": {
+			method:           http.MethodGet,
+			failures:         5,
+			ctx:              context.Background(),
+			expectedAttempts: 3,
+			expectErr:        true,
+		},
+		"POST carrying an idempotency key is retried": {
+			method:           http.MethodPost,
+			failures:         1,
+			ctx:              WithIdempotencyKey(context.Background(), "key"),
+			expectedAttempts: 2,
+		},
+		"context override disables retries": {
+			method:           http.MethodGet,
+			failures:         1,
+			ctx:              WithRetryPolicy(context.Background(), NoRetryPolicy),
+			expectedAttempts: 1,
+			expectErr:        true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var attempts int32
+			ts := newRetryTestServer(t, tt.failures, nil, &attempts)
+			defer ts.Close()
+
+			c := &Call{
+				baseURL:     ts.URL,
+				client:      resty.New(),
+				logger:      zerolog.Nop(),
+				retryPolicy: policy,
+			}
+
+			var response string
+			err := c.makeRequest(tt.ctx, "test", "/retry", tt.method, nil, nil, nil, nil, &response)
+			assert.Equal(t, tt.expectedAttempts, atomic.LoadInt32(&attempts))
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "ok", response)
+		})
+	}
+}
+
+func TestMakeRequestRetryAfter(t *testing.T) {
+	var attempts int32
+	ts := newRetryTestServer(t, 1, http.Header{"Retry-After": []string{"1"}}, &attempts)
+	defer ts.Close()
+
+	c := &Call{
+		baseURL:     ts.URL,
+		client:      resty.New(),
+		logger:      zerolog.Nop(),
+		retryPolicy: RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond},
+	}
+
+	start := time.Now()
+	err := c.makeRequest(context.Background(), "test", "/retry", http.MethodGet, nil, nil, nil, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&attempts))
+	assert.GreaterOrEqual(t, time.Since(start), time.Second)
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second, Multiplier: 2}
+
+	assert.Equal(t, 100*time.Millisecond, policy.backoff(1, 0))
+	assert.Equal(t, 400*time.Millisecond, policy.backoff(3, 0))
+	assert.Equal(t, time.Second, policy.backoff(10, 0))
+	assert.Equal(t, 2*time.Second, policy.backoff(1, 2*time.Second))
+
+	policy.Jitter = 0.5
+	for i := 0; i < 10; i++ {
+		wait := policy.backoff(2, 0)
+		assert.GreaterOrEqual(t, wait, 100*time.Millisecond)
+		assert.LessOrEqual(t, wait, 200*time.Millisecond)
+	}
+}
+
+func TestIsReplayable(t *testing.T) {
+	assert.True(t, isReplayable(http.MethodGet, nil, false))
+	assert.True(t, isReplayable(http.MethodDelete, nil, false))
+	assert.False(t, isReplayable(http.MethodPost, nil, false))
+	assert.True(t, isReplayable(http.MethodPost, nil, true))
+	assert.False(t, isReplayable(http.MethodPost, map[string]interface{}{"currency": "USD"}, true))
+}
@@ -0,0 +1,66 @@
+package api
+
+import (
+	"context"
+	"time"
+)
+
+// TimeoutPolicy sets how long a call may take, retries included, when the context of the call has no earlier deadline.
+// The timeout of the operation wins, then the upload timeout for calls sending files, then the timeout of the endpoint
+// group, then the default. A zero timeout leaves the call without a deadline
+type TimeoutPolicy struct {
+	// Default is the timeout of the calls that match nothing more specific
+	Default time.Duration
+	// Uploads is the timeout of the calls sending files as multipart form data
+	Uploads time.Duration
+	// Groups holds the timeouts of endpoint groups
+	Groups map[EndpointGroup]time.Duration
+	// Operations holds the timeouts of RemoteCalls methods, by name, e.g. InitiateDirectBulkPayout
+	Operations map[string]time.Duration
+}
+
+// DefaultTimeoutPolicy gives reads of reference data a short timeout and uploads, bill payments and bulk payouts a long one
+var DefaultTimeoutPolicy = TimeoutPolicy{
+	Default: 30 * time.Second,
+	Uploads: 2 * time.Minute,
+	Groups: map[EndpointGroup]time.Duration{
+		GroupReferenceData: 10 * time.Second,
+		GroupBills:         time.Minute,
+	},
+	Operations: map[string]time.Duration{
+		"InitiateDirectBulkPayout": time.Minute,
+	},
+}
+
+// WithTimeoutPolicy sets the timeouts of the calls made by the client. Defaults to DefaultTimeoutPolicy
+func WithTimeoutPolicy(policy TimeoutPolicy) Option {
+	return func(o *options) {
+		o.timeoutPolicy = policy
+	}
+}
+
+// timeoutFor returns the timeout of a call, 0 when it has none
+func (p TimeoutPolicy) timeoutFor(request *Request) time.Duration {
+	if timeout, ok := p.Operations[request.Operation]; ok {
+		return timeout
+	}
+	if request.FormData != nil && p.Uploads > 0 {
+		return p.Uploads
+	}
+	if timeout, ok := p.Groups[request.Group]; ok {
+		return timeout
+	}
+	return p.Default
+}
+
+// withTimeout bounds the context of a call by the timeout of the policy. An earlier deadline of the context is kept
+func (c *Call) withTimeout(ctx context.Context, request *Request) (context.Context, context.CancelFunc) {
+	timeout := c.timeoutPolicy.timeoutFor(request)
+	if timeout <= 0 && c.cache.ttl(request) > 0 {
+		timeout = cacheFetchTimeout
+	}
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, timeout)
+}
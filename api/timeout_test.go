@@ -0,0 +1,112 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ovalfi/go-sdk/model"
+)
+
+func TestTimeoutPolicyTimeoutFor(t *testing.T) {
+	policy := TimeoutPolicy{
+		Default:    time.Second,
+		Uploads:    time.Minute,
+		Groups:     map[EndpointGroup]time.Duration{GroupReferenceData: 2 * time.Second},
+		Operations: map[string]time.Duration{"InitiateDirectBulkPayout": 3 * time.Second, "InitiatePayoutUpload": 4 * time.Second},
+	}
+
+	tests := map[string]struct {
+		request  Request
+		expected time.Duration
+	}{
+		"default": {
+			request:  Request{Operation: "InitiateTransfer", Group: GroupPayments},
+			expected: time.Second,
+		},
+		"group": {
+			request:  Request{Operation: "GetBanks", Group: GroupReferenceData},
+			expected: 2 * time.Second,
+		},
+		"operation": {
+			request:  Request{Operation: "InitiateDirectBulkPayout", Group: GroupPayments},
+			expected: 3 * time.Second,
+		},
+		"upload": {
+			request:  Request{Operation: "SubmitCustomerKYCDocumentUpload", Group: GroupKYC, FormData: map[string]interface{}{}},
+			expected: time.Minute,
+		},
+		"operation wins over upload": {
+			request:  Request{Operation: "InitiatePayoutUpload", Group: GroupPayments, FormData: map[string]interface{}{}},
+			expected: 4 * time.Second,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, policy.timeoutFor(&tt.request))
+		})
+	}
+
+	assert.Zero(t, TimeoutPolicy{}.timeoutFor(&Request{Operation: "GetBanks"}))
+}
+
+func TestTimeoutPolicyApplied(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(300 * time.Millisecond):
+		case <-r.Context().Done():
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	defer ts.Close()
+
+	tests := map[string]struct {
+		policy      TimeoutPolicy
+		ctxTimeout  time.Duration
+		expectedErr error
+	}{
+		"policy shorter than the context deadline": {
+			policy:      TimeoutPolicy{Groups: map[EndpointGroup]time.Duration{GroupReferenceData: 50 * time.Millisecond}},
+			ctxTimeout:  10 * time.Second,
+			expectedErr: context.DeadlineExceeded,
+		},
+		"earlier context deadline is kept": {
+			policy:      TimeoutPolicy{Default: 10 * time.Second},
+			ctxTimeout:  50 * time.Millisecond,
+			expectedErr: context.DeadlineExceeded,
+		},
+		"timeout of another group": {
+			policy: TimeoutPolicy{Groups: map[EndpointGroup]time.Duration{GroupPayments: 50 * time.Millisecond}},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := newTestCall(ts.URL)
+			c.timeoutPolicy = tt.policy
+
+			ctx := context.Background()
+			if tt.ctxTimeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.ctxTimeout)
+				defer cancel()
+			}
+
+			start := time.Now()
+			_, err := c.ResolveBankAccount(ctx, model.AccountResolveRequest{BankCode: "058"})
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Equal(t, "timeout", classifyError(err))
+				assert.Less(t, time.Since(start), 250*time.Millisecond)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ovalfi/go-sdk/helpers"
+	"github.com/ovalfi/go-sdk/model"
+)
+
+const (
+	// tracerName is the instrumentation name of the spans opened by the SDK
+	tracerName = "github.com/ovalfi/go-sdk"
+
+	// spanNamePrefix prefixes the RemoteCalls method name to build the span name
+	spanNamePrefix = "ovalfi."
+)
+
+// span attribute keys specific to the SDK
+var (
+	attrEndpoint   = attribute.Key("ovalfi.endpoint")
+	attrOperation  = attribute.Key("ovalfi.operation")
+	attrReference  = attribute.Key("ovalfi.reference")
+	attrErrorClass = attribute.Key("ovalfi.error.class")
+	attrErrorID    = attribute.Key("ovalfi.error.id")
+	attrAttempt    = attribute.Key("ovalfi.attempt")
+	attrBusinessID = attribute.Key("ovalfi.business_id")
+	attrCache      = attribute.Key("ovalfi.cache")
+)
+
+// WithTracerProvider opens a span for every RemoteCalls method using the given tracer provider
+// and propagates the trace context on the outgoing requests
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(o *options) {
+		o.tracerProvider = provider
+	}
+}
+
+// WithPropagator sets the propagator used to inject the trace context in the outgoing requests.
+// Defaults to W3C trace context and baggage
+func WithPropagator(propagator propagation.TextMapPropagator) Option {
+	return func(o *options) {
+		o.propagator = propagator
+	}
+}
+
+// tracing runs a call within the span of its RemoteCalls method. The span is opened above the cache, so that calls
+// served from it are traced as well
+func (c *Call) tracing(ctx context.Context, request *Request, call func(context.Context) error) error {
+	attributes := []attribute.KeyValue{
+		attrOperation.String(request.Operation),
+		attrEndpoint.String(helpers.RedactPath(request.Path)),
+		semconv.HTTPRequestMethodKey.String(request.Method),
+	}
+	if reference := referenceOf(request.Body); reference != "" {
+		attributes = append(attributes, attrReference.String(reference))
+	}
+	if c.businessID != "" {
+		attributes = append(attributes, attrBusinessID.String(c.businessID))
+	}
+
+	ctx, span := c.tracer.Start(ctx, spanNamePrefix+request.Operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attributes...),
+	)
+	defer span.End()
+
+	if err := call(ctx); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		span.SetAttributes(attrErrorClass.String(classifyError(err)))
+
+		var apiErr *model.APIError
+		if errors.As(err, &apiErr) && apiErr.ID != "" {
+			span.SetAttributes(attrErrorID.String(apiErr.ID))
+		}
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "")
+	return nil
+}
+
+// recordCache adds how a cached call was served to the span of the call, if any
+func recordCache(ctx context.Context, result cacheResult) {
+	span := trace.SpanFromContext(ctx)
+	if !span.IsRecording() {
+		return
+	}
+	span.SetAttributes(attrCache.String(string(result)))
+}
+
+// recordAttempt adds the outcome of a single HTTP attempt to the span of the call, if any
+func recordAttempt(ctx context.Context, attempt, statusCode int) {
+	span := trace.SpanFromContext(ctx)
+	if !span.IsRecording() {
+		return
+	}
+	span.SetAttributes(attrAttempt.Int(attempt))
+	if statusCode != 0 {
+		span.SetAttributes(semconv.HTTPResponseStatusCode(statusCode))
+	}
+}
+
+// classifyError maps an error returned by the SDK to a short, low cardinality class
+func classifyError(err error) string {
+	switch {
+	case err == nil:
+		return ""
+	case errors.Is(err, ErrCircuitOpen):
+		return "circuit_open"
+	case errors.Is(err, context.Canceled):
+		return "canceled"
+	case errors.Is(err, context.DeadlineExceeded):
+		return "timeout"
+	case errors.Is(err, model.ErrNotFound):
+		return "not_found"
+	case errors.Is(err, model.ErrDuplicateReference):
+		return "duplicate_reference"
+	case errors.Is(err, model.ErrInsufficientBalance):
+		return "insufficient_balance"
+	case errors.Is(err, model.ErrUnauthorized):
+		return "unauthorized"
+	case errors.Is(err, model.ErrValidation):
+		return "validation"
+	case errors.Is(err, model.ErrNetworkError):
+		return "network"
+	}
+
+	var apiErr *model.APIError
+	if errors.As(err, &apiErr) {
+		if apiErr.Temporary() {
+			return "server"
+		}
+		return "client"
+	}
+	return "internal"
+}
@@ -0,0 +1,126 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ovalfi/go-sdk/model"
+)
+
+func spanAttributes(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	attributes := make(map[attribute.Key]attribute.Value)
+	for _, kv := range span.Attributes() {
+		attributes[kv.Key] = kv.Value
+	}
+	return attributes
+}
+
+func TestTracingSuccess(t *testing.T) {
+	var traceParent string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceParent = r.Header.Get("Traceparent")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(`{"data":{"status":"pending"}}`))
+		assert.NoError(t, err)
+	}))
+	defer ts.Close()
+
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+	client, err := NewClient(WithCredentials("secret", "token"), WithBaseURL(ts.URL+"/"), WithTracerProvider(provider))
+	assert.NoError(t, err)
+
+	_, err = client.InitiateTerminalTransfer(context.Background(), model.InitiateTerminalTransferRequest{Amount: 10, SourceCurrency: "USD"})
+	assert.NoError(t, err)
+
+	spans := exporter.GetSpans().Snapshots()
+	assert.Len(t, spans, 1)
+
+	span := spans[0]
+	assert.Equal(t, "ovalfi.InitiateTerminalTransfer", span.Name())
+	assert.Equal(t, trace.SpanKindClient, span.SpanKind())
+	assert.Equal(t, codes.Ok, span.Status().Code)
+
+	attributes := spanAttributes(span)
+	assert.Equal(t, "v1/transfers", attributes[attrEndpoint].AsString())
+	assert.Equal(t, http.MethodPost, attributes["http.request.method"].AsString())
+	assert.Equal(t, int64(http.StatusOK), attributes["http.response.status_code"].AsInt64())
+
+	carrier := propagation.HeaderCarrier(http.Header{"Traceparent": []string{traceParent}})
+	remote := trace.SpanContextFromContext(propagation.TraceContext{}.Extract(context.Background(), carrier))
+	assert.Equal(t, span.SpanContext().TraceID(), remote.TraceID())
+}
+
+func TestTracingError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusConflict)
+		_, err := w.Write([]byte(`{"error":{"id":"duplicate_reference","details":"reference already exists"}}`))
+		assert.NoError(t, err)
+	}))
+	defer ts.Close()
+
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+	client, err := NewClient(WithCredentials("secret", "token"), WithBaseURL(ts.URL+"/"), WithTracerProvider(provider))
+	assert.NoError(t, err)
+
+	_, err = client.InitiateDeposit(context.Background(), model.InitiateDepositRequest{Reference: "dep-42"})
+	assert.ErrorIs(t, err, model.ErrDuplicateReference)
+
+	spans := exporter.GetSpans().Snapshots()
+	assert.Len(t, spans, 1)
+
+	span := spans[0]
+	assert.Equal(t, "ovalfi.InitiateDeposit", span.Name())
+	assert.Equal(t, codes.Error, span.Status().Code)
+
+	attributes := spanAttributes(span)
+	assert.Equal(t, "dep-42", attributes[attrReference].AsString())
+	assert.Equal(t, "duplicate_reference", attributes[attrErrorClass].AsString())
+	assert.Equal(t, "duplicate_reference", attributes[attrErrorID].AsString())
+	assert.Equal(t, int64(http.StatusConflict), attributes["http.response.status_code"].AsInt64())
+}
+
+func TestTracingCacheHit(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":[{"code":"044","name":"Access Bank"}]}`))
+	}))
+	defer ts.Close()
+
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+	client, err := NewClient(WithCredentials("secret", "token"), WithBaseURL(ts.URL+"/"), WithTracerProvider(provider),
+		WithReferenceDataCache(DefaultCacheSettings))
+	assert.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		_, err = client.GetBanks(context.Background())
+		assert.NoError(t, err)
+	}
+
+	spans := exporter.GetSpans().Snapshots()
+	assert.Len(t, spans, 2)
+	for i, result := range []string{"miss", "hit"} {
+		assert.Equal(t, "ovalfi.GetBanks", spans[i].Name())
+		assert.Equal(t, codes.Ok, spans[i].Status().Code)
+		assert.Equal(t, result, spanAttributes(spans[i])[attrCache].AsString())
+	}
+	_, attempted := spanAttributes(spans[1])[attrAttempt]
+	assert.False(t, attempted)
+}
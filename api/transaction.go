@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strconv"
 
 	"github.com/ovalfi/go-sdk/helpers"
 	"github.com/ovalfi/go-sdk/model"
@@ -12,8 +11,13 @@ import (
 
 const transactionAPIVersion = "v1/transaction"
 
-// GetTransactions makes request to Torus to get all transactions
-func (c *Call) GetTransactions(ctx context.Context, customerID, yieldOfferingID, status, reference, batchDate string, amount *float64, dateBetween *model.DateBetween, page *model.Page) (model.AllTransactionsResponse, error) {
+// ListTransactions makes request to Torus to get the transactions matching the filter
+func (c *Call) ListTransactions(ctx context.Context, filter model.TransactionFilter, page *model.Page) (model.AllTransactionsResponse, error) {
+	return c.listTransactions(ctx, "ListTransactions", filter, page)
+}
+
+// listTransactions reports its request as operation, so that the deprecated GetTransactions keeps its own name
+func (c *Call) listTransactions(ctx context.Context, operation string, filter model.TransactionFilter, page *model.Page) (model.AllTransactionsResponse, error) {
 	var (
 		err      error
 		response model.AllTransactionsResponse
@@ -21,36 +25,33 @@ func (c *Call) GetTransactions(ctx context.Context, customerID, yieldOfferingID,
 		path     = transactionAPIVersion
 	)
 
-	if customerID != "" {
-		params["customer_id"] = customerID
-	}
-	if yieldOfferingID != "" {
-		params["yield_offering_id"] = yieldOfferingID
-	}
-	if status != "" {
-		params["status"] = status
-	}
-	if reference != "" {
-		params["reference"] = reference
-	}
-	if batchDate != "" {
-		params["batch_date"] = batchDate
-	}
-	if amount != nil {
-		params["amount"] = strconv.FormatFloat(*amount, 'f', -1, 64)
-	}
-	if dateBetween != nil {
-		helpers.FillParamsWithDateInterval(params, *dateBetween)
+	if err = helpers.FillParamsWithFilter(params, filter); err != nil {
+		return response, err
 	}
 	if page != nil {
 		helpers.FillParamsWithPage(params, *page)
 	}
 
-	err = c.makeRequest(ctx, path, http.MethodGet, nil, params, nil, nil, &response)
+	err = c.makeRequest(ctx, operation, path, http.MethodGet, nil, params, nil, nil, &response)
 
 	return response, err
 }
 
+// GetTransactions makes request to Torus to get all transactions
+//
+// Deprecated: use ListTransactions, which takes a TransactionFilter
+func (c *Call) GetTransactions(ctx context.Context, customerID, yieldOfferingID, status, reference, batchDate string, amount *float64, dateBetween *model.DateBetween, page *model.Page) (model.AllTransactionsResponse, error) {
+	return c.listTransactions(ctx, "GetTransactions", model.TransactionFilter{
+		CustomerID:      customerID,
+		YieldOfferingID: yieldOfferingID,
+		Status:          status,
+		Reference:       reference,
+		BatchDate:       batchDate,
+		Amount:          amount,
+		Dates:           dateBetween,
+	}, page)
+}
+
 // CancelTransaction makes request to Torus to cancel transaction
 func (c *Call) CancelTransaction(ctx context.Context, transactionID, transactionType, reason string) error {
 	var (
@@ -59,7 +60,7 @@ func (c *Call) CancelTransaction(ctx context.Context, transactionID, transaction
 		path   = fmt.Sprintf("%s/%s", transactionAPIVersion, transactionID)
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodDelete, nil, params, nil, nil, nil)
+	err = c.makeRequest(ctx, "CancelTransaction", path, http.MethodDelete, nil, params, nil, nil, nil)
 
 	return err
 }
@@ -76,7 +77,7 @@ func (c *Call) CancelBatchTransaction(ctx context.Context, batchDate, transactio
 		params["currency"] = currency
 	}
 
-	err = c.makeRequest(ctx, path, http.MethodDelete, nil, params, nil, nil, nil)
+	err = c.makeRequest(ctx, "CancelBatchTransaction", path, http.MethodDelete, nil, params, nil, nil, nil)
 
 	return err
 }
@@ -89,7 +90,7 @@ func (c *Call) GetBalances(ctx context.Context) (map[string]float64, error) {
 		path     = "v1/balances"
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodGet, nil, nil, nil, nil, &response)
+	err = c.makeRequest(ctx, "GetBalances", path, http.MethodGet, nil, nil, nil, nil, &response)
 
 	return response, err
 }
@@ -24,10 +24,10 @@ func (c *Call) InitiateTransfer(ctx context.Context, request model.InitiateTrans
 		err       error
 		response  model.TransferResponse
 		path      = customerTransferAPIVersion
-		signature = helpers.GetSignatureFromReferenceAndPubKey(request.Reference, c.apiSecret)
+		reference = request.Reference
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodPost, &signature, nil, nil, request, &response)
+	err = c.makeRequest(ctx, "InitiateTransfer", path, http.MethodPost, &reference, nil, nil, request, &response)
 
 	return response, err
 }
@@ -47,7 +47,7 @@ func (c *Call) GetExchangeRates(ctx context.Context, amount float64, sourceCurre
 	strAmount := strconv.FormatFloat(amount, 'f', -1, 64)
 	params["amount"] = strAmount
 
-	err = c.makeRequest(ctx, path, http.MethodGet, nil, params, nil, nil, &response)
+	err = c.makeRequest(ctx, "GetExchangeRates", path, http.MethodGet, nil, params, nil, nil, &response)
 
 	return response, err
 }
@@ -60,7 +60,7 @@ func (c *Call) GetTransferByID(ctx context.Context, transferID string) (model.Tr
 		path     = fmt.Sprintf("%s/%s", customerTransferAPIVersion, transferID)
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodGet, nil, nil, nil, nil, &response)
+	err = c.makeRequest(ctx, "GetTransferByID", path, http.MethodGet, nil, nil, nil, nil, &response)
 
 	return response, err
 }
@@ -75,7 +75,7 @@ func (c *Call) DeleteTransfer(ctx context.Context, transferID, reason string) er
 		path = fmt.Sprintf("%s/%s", customerTransferAPIVersion, transferID)
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodDelete, nil, params, nil, nil, nil)
+	err = c.makeRequest(ctx, "DeleteTransfer", path, http.MethodDelete, nil, params, nil, nil, nil)
 
 	return err
 }
@@ -91,7 +91,7 @@ func (c *Call) DeleteTransferBatch(ctx context.Context, batchDate, currency, rea
 		path = fmt.Sprintf("%s/delete-by-batch/%s", customerTransferAPIVersion, batchDate)
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodDelete, nil, params, nil, nil, nil)
+	err = c.makeRequest(ctx, "DeleteTransferBatch", path, http.MethodDelete, nil, params, nil, nil, nil)
 
 	return err
 }
@@ -104,13 +104,18 @@ func (c *Call) InitiateTerminalTransfer(ctx context.Context, request model.Initi
 		path     = transferAPIVersion
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodPost, nil, nil, nil, request, &response)
+	err = c.makeRequest(ctx, "InitiateTerminalTransfer", path, http.MethodPost, nil, nil, nil, request, &response)
 
 	return response, err
 }
 
-// GetTerminalTransfers makes request to Torus to get all terminal transfers
-func (c *Call) GetTerminalTransfers(ctx context.Context, status, sourceCurrency, destinationCurrency string, dateBetween *model.DateBetween, page *model.Page) (model.AllTransfersResponse, error) {
+// ListTerminalTransfers makes request to Torus to get the terminal transfers matching the filter
+func (c *Call) ListTerminalTransfers(ctx context.Context, filter model.TerminalTransferFilter, page *model.Page) (model.AllTransfersResponse, error) {
+	return c.listTerminalTransfers(ctx, "ListTerminalTransfers", filter, page)
+}
+
+// listTerminalTransfers backs ListTerminalTransfers and the deprecated GetTerminalTransfers, reported as operation
+func (c *Call) listTerminalTransfers(ctx context.Context, operation string, filter model.TerminalTransferFilter, page *model.Page) (model.AllTransfersResponse, error) {
 	var (
 		err      error
 		response model.AllTransfersResponse
@@ -118,27 +123,30 @@ func (c *Call) GetTerminalTransfers(ctx context.Context, status, sourceCurrency,
 		path     = transferAPIVersion
 	)
 
-	if status != "" {
-		params["status"] = status
-	}
-	if sourceCurrency != "" {
-		params["source_currency"] = sourceCurrency
-	}
-	if destinationCurrency != "" {
-		params["destination_currency"] = destinationCurrency
-	}
-	if dateBetween != nil {
-		helpers.FillParamsWithDateInterval(params, *dateBetween)
+	if err = helpers.FillParamsWithFilter(params, filter); err != nil {
+		return response, err
 	}
 	if page != nil {
 		helpers.FillParamsWithPage(params, *page)
 	}
 
-	err = c.makeRequest(ctx, path, http.MethodGet, nil, params, nil, nil, &response)
+	err = c.makeRequest(ctx, operation, path, http.MethodGet, nil, params, nil, nil, &response)
 
 	return response, err
 }
 
+// GetTerminalTransfers makes request to Torus to get all terminal transfers
+//
+// Deprecated: use ListTerminalTransfers, which takes a TerminalTransferFilter
+func (c *Call) GetTerminalTransfers(ctx context.Context, status, sourceCurrency, destinationCurrency string, dateBetween *model.DateBetween, page *model.Page) (model.AllTransfersResponse, error) {
+	return c.listTerminalTransfers(ctx, "GetTerminalTransfers", model.TerminalTransferFilter{
+		Status:              status,
+		SourceCurrency:      sourceCurrency,
+		DestinationCurrency: destinationCurrency,
+		Dates:               dateBetween,
+	}, page)
+}
+
 // GetTerminalTransferByID makes request to Torus to get terminal transfer by its ID
 func (c *Call) GetTerminalTransferByID(ctx context.Context, transferID string) (model.TerminalTransfer, error) {
 	var (
@@ -147,7 +155,7 @@ func (c *Call) GetTerminalTransferByID(ctx context.Context, transferID string) (
 		path     = fmt.Sprintf("%s/%s", transferAPIVersion, transferID)
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodGet, nil, nil, nil, nil, &response)
+	err = c.makeRequest(ctx, "GetTerminalTransferByID", path, http.MethodGet, nil, nil, nil, nil, &response)
 
 	return response, err
 }
@@ -160,7 +168,7 @@ func (c *Call) GetSettlementByID(ctx context.Context, settlementID string) (mode
 		path     = fmt.Sprintf("%s/%s", settlementAPIVersion, settlementID)
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodGet, nil, nil, nil, nil, &response)
+	err = c.makeRequest(ctx, "GetSettlementByID", path, http.MethodGet, nil, nil, nil, nil, &response)
 
 	return response, err
 }
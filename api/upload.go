@@ -0,0 +1,156 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ovalfi/go-sdk/model"
+)
+
+// DefaultMaxUploadSize is the largest file the client uploads unless configured otherwise
+const DefaultMaxUploadSize int64 = 10 << 20
+
+// sniffLen is the number of bytes read to detect the content type of an upload
+const sniffLen = 512
+
+var (
+	// ErrUploadTooLarge is returned when a file exceeds the maximum upload size of the client
+	ErrUploadTooLarge = fmt.Errorf("%w: upload too large", model.ErrValidation)
+
+	// ErrInvalidUpload is returned when a file has no content or no name
+	ErrInvalidUpload = fmt.Errorf("%w: invalid upload", model.ErrValidation)
+)
+
+// WithMaxUploadSize sets the largest file the client uploads, defaults to DefaultMaxUploadSize
+func WithMaxUploadSize(size int64) Option {
+	return func(o *options) {
+		o.maxUploadSize = size
+	}
+}
+
+// prepareUpload checks an upload against the size limit of the client before anything is sent, detects its content type
+// when missing and guards its reader so that a stream longer than announced is cut at the limit. The context must carry
+// the request ID of the call, which failures are logged and returned with
+func (c *Call) prepareUpload(ctx context.Context, field string, upload model.Upload) (model.Upload, error) {
+	upload, err := c.checkUpload(field, upload)
+	if err != nil {
+		log := c.requestLogger(ctx)
+		log.Err(err).Str("field", field).Msg("invalid upload")
+		return upload, requestError(ctx, err)
+	}
+	return upload, nil
+}
+
+// checkUpload backs prepareUpload
+func (c *Call) checkUpload(field string, upload model.Upload) (model.Upload, error) {
+	if upload.Reader == nil || upload.Filename == "" {
+		return upload, fmt.Errorf("%s: %w", field, ErrInvalidUpload)
+	}
+
+	limit := c.maxUploadSize
+	if limit <= 0 {
+		limit = DefaultMaxUploadSize
+	}
+	if upload.Size > limit {
+		return upload, fmt.Errorf("%s: %w: %d bytes, the limit is %d", field, ErrUploadTooLarge, upload.Size, limit)
+	}
+
+	if upload.ContentType == "" {
+		upload.ContentType = mime.TypeByExtension(filepath.Ext(upload.Filename))
+	}
+	if upload.ContentType == "" {
+		head := make([]byte, sniffLen)
+		n, err := io.ReadFull(upload.Reader, head)
+		if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+			return upload, fmt.Errorf("%s: %w", field, err)
+		}
+		upload.ContentType = http.DetectContentType(head[:n])
+		upload.Reader = io.MultiReader(bytes.NewReader(head[:n]), upload.Reader)
+	}
+
+	upload.Reader = &limitedReader{field: field, reader: upload.Reader, remaining: limit}
+	return upload, nil
+}
+
+// limitedReader fails with ErrUploadTooLarge instead of returning more than remaining bytes
+type limitedReader struct {
+	field     string
+	reader    io.Reader
+	remaining int64
+}
+
+// Read implements io.Reader
+func (l *limitedReader) Read(p []byte) (int, error) {
+	if int64(len(p)) > l.remaining+1 {
+		p = p[:l.remaining+1]
+	}
+	n, err := l.reader.Read(p)
+	l.remaining -= int64(n)
+	if l.remaining < 0 {
+		return n, fmt.Errorf("%s: %w", l.field, ErrUploadTooLarge)
+	}
+	return n, err
+}
+
+// multipartBody streams the form data as a multipart body, writing the uploads as the transport reads them.
+// The returned reader must be closed for the writer to stop when the request is not sent
+func multipartBody(formData map[string]interface{}) (io.ReadCloser, string) {
+	reader, writer := io.Pipe()
+	form := multipart.NewWriter(writer)
+
+	go func() {
+		writer.CloseWithError(writeMultipart(form, formData))
+	}()
+
+	return reader, form.FormDataContentType()
+}
+
+func writeMultipart(form *multipart.Writer, formData map[string]interface{}) error {
+	fields := make([]string, 0, len(formData))
+	for field := range formData {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	for _, field := range fields {
+		switch value := formData[field].(type) {
+		case model.Upload:
+			header := make(textproto.MIMEHeader)
+			header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`, escapeQuotes(field), escapeQuotes(value.Filename)))
+			header.Set("Content-Type", value.ContentType)
+
+			part, err := form.CreatePart(header)
+			if err != nil {
+				return err
+			}
+			if _, err = io.Copy(part, value.Reader); err != nil {
+				return err
+			}
+		case string:
+			if err := form.WriteField(field, value); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("%s: unsupported form value %T", field, value)
+		}
+	}
+
+	return form.Close()
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// escapeQuotes escapes the field and file names like mime/multipart does
+func escapeQuotes(s string) string {
+	return quoteEscaper.Replace(s)
+}
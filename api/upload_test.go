@@ -0,0 +1,151 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ovalfi/go-sdk/model"
+)
+
+// pngHeader is enough of a PNG file for its content type to be detected
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+func TestSubmitCustomerKYCDocumentUpload(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/"+kycAPIVersion+"/cus-1/document", r.URL.Path)
+		assert.True(t, strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data; boundary="))
+		require.NoError(t, r.ParseMultipartForm(1<<20))
+
+		assert.Equal(t, "passport", r.FormValue("document_type"))
+		assert.Equal(t, "NG", r.FormValue("country"))
+
+		front, header, err := r.FormFile("document_front_side")
+		require.NoError(t, err)
+		assert.Equal(t, "front", header.Filename)
+		assert.Equal(t, "image/png", header.Header.Get("Content-Type"))
+		content, err := io.ReadAll(front)
+		require.NoError(t, err)
+		assert.Equal(t, pngHeader, content)
+
+		back, header, err := r.FormFile("document_back_side")
+		require.NoError(t, err)
+		assert.Equal(t, "back.jpg", header.Filename)
+		assert.Equal(t, "image/jpeg", header.Header.Get("Content-Type"))
+		content, err = io.ReadAll(back)
+		require.NoError(t, err)
+		assert.Equal(t, "jpeg", string(content))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	defer ts.Close()
+
+	c := newTestCall(ts.URL)
+	_, err := c.SubmitCustomerKYCDocumentUpload(context.Background(), "cus-1",
+		model.Upload{Reader: bytes.NewReader(pngHeader), Filename: "front"},
+		&model.Upload{Reader: strings.NewReader("jpeg"), Filename: "back.jpg"},
+		"passport", "NG",
+	)
+	assert.NoError(t, err)
+}
+
+func TestInitiatePayoutFromFile(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseMultipartForm(1<<20))
+		assert.Equal(t, "USD", r.FormValue("currency"))
+
+		document, header, err := r.FormFile("document")
+		require.NoError(t, err)
+		assert.Equal(t, "payouts.csv", header.Filename)
+		assert.Contains(t, header.Header.Get("Content-Type"), "text/csv")
+		content, err := io.ReadAll(document)
+		require.NoError(t, err)
+		assert.Equal(t, "account_number,amount\n0123456789,10\n", string(content))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"data":{"status":"pending","count":1}}`))
+	}))
+	defer ts.Close()
+
+	name := filepath.Join(t.TempDir(), "payouts.csv")
+	require.NoError(t, os.WriteFile(name, []byte("account_number,amount\n0123456789,10\n"), 0o600))
+	file, err := os.Open(name)
+	require.NoError(t, err)
+	defer file.Close()
+
+	c := newTestCall(ts.URL)
+	payout, err := c.InitiatePayout(context.Background(), "USD", "banks", "multiple", "salaries", nil, file)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, payout.Count)
+}
+
+func TestUploadLimits(t *testing.T) {
+	var hits int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		_, _ = io.Copy(io.Discard, r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	defer ts.Close()
+
+	c := newTestCall(ts.URL)
+	c.maxUploadSize = 8
+
+	tests := map[string]struct {
+		upload      model.Upload
+		expectedErr error
+		sent        bool
+	}{
+		"announced size over the limit": {
+			upload:      model.Upload{Reader: strings.NewReader("0123456789"), Filename: "payouts.csv", Size: 10},
+			expectedErr: ErrUploadTooLarge,
+		},
+		"stream longer than the limit": {
+			upload:      model.Upload{Reader: strings.NewReader("0123456789"), Filename: "payouts.csv"},
+			expectedErr: ErrUploadTooLarge,
+			sent:        true,
+		},
+		"missing reader": {
+			upload:      model.Upload{Filename: "payouts.csv"},
+			expectedErr: ErrInvalidUpload,
+		},
+		"missing filename": {
+			upload:      model.Upload{Reader: strings.NewReader("0123")},
+			expectedErr: ErrInvalidUpload,
+		},
+		"within the limit": {
+			upload: model.Upload{Reader: strings.NewReader("01234567"), Filename: "payouts.csv"},
+			sent:   true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			atomic.StoreInt32(&hits, 0)
+			_, err := c.InitiatePayoutUpload(context.Background(), "USD", "banks", "multiple", "salaries", nil, tt.upload)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.ErrorIs(t, err, model.ErrValidation)
+			} else {
+				assert.NoError(t, err)
+			}
+			if !tt.sent {
+				assert.Zero(t, atomic.LoadInt32(&hits))
+			}
+		})
+	}
+}
@@ -2,73 +2,323 @@ package api
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
-	"mime"
+	"io"
 	"net/http"
-	"os"
-	"path/filepath"
-	"reflect"
+	"net/url"
 	"time"
 
 	"github.com/go-resty/resty/v2"
 	"github.com/google/uuid"
-	"github.com/mitchellh/mapstructure"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/propagation"
 
 	"github.com/ovalfi/go-sdk/helpers"
 	"github.com/ovalfi/go-sdk/model"
 )
 
-func (c *Call) makeRequest(ctx context.Context, path, method string, signature *string, params, formData map[string]interface{}, requestBody, responseData interface{}) error {
-	endpoint := fmt.Sprintf("%s%s", c.baseURL, path)
+// makeRequest sends a request on behalf of operation, the name of the RemoteCalls method making it
+func (c *Call) makeRequest(ctx context.Context, operation, path, method string, signedReference *string, params, formData map[string]interface{}, requestBody, responseData interface{}) error {
+	ctx = withRequestID(ctx)
+	log := c.requestLogger(ctx)
 
-	log := c.logger.With().Str("method", method).Str("endpoint", endpoint).Logger()
+	request := &Request{
+		Operation: operation,
+		Group:     groupOf(operation),
+		Method:    method,
+		Path:      path,
+		Params:    params,
+		FormData:  formData,
+		Body:      requestBody,
+		Header:    http.Header{},
+
+		SignedReference: signedReference,
+	}
+
+	if key := idempotencyKey(ctx, method, path, requestBody); key != "" {
+		request.Header.Set(model.IdempotencyKeyHeaderKey, key)
+	}
+
+	var err error
+	if c.tracer != nil {
+		err = c.tracing(ctx, request, func(ctx context.Context) error {
+			return c.call(ctx, log, request, responseData)
+		})
+	} else {
+		err = c.call(ctx, log, request, responseData)
+	}
+	return requestError(ctx, err)
+}
+
+// withRequestID returns a context carrying a request ID, generating one when the context has none
+func withRequestID(ctx context.Context) context.Context {
+	if helpers.GetRequestID(ctx) == "" {
+		return helpers.WithRequestID(ctx, uuid.NewString())
+	}
+	return ctx
+}
+
+// requestLogger returns the logger of a call, which writes its request ID on every line
+func (c *Call) requestLogger(ctx context.Context) zerolog.Logger {
+	return c.logger.With().Str(model.LogRequestID, helpers.GetRequestID(ctx)).Logger()
+}
+
+// requestError attaches the request ID of the context to an error that carries none, so that model.RequestIDOf
+// returns it for client side failures as well
+func requestError(ctx context.Context, err error) error {
+	if err == nil || model.RequestIDOf(err) != "" {
+		return err
+	}
+	return &model.RequestError{RequestID: helpers.GetRequestID(ctx), Err: err}
+}
+
+// call fetches the data of a request, from the cache when it is cached, and decodes it into responseData
+func (c *Call) call(ctx context.Context, log zerolog.Logger, request *Request, responseData interface{}) error {
+	var (
+		data json.RawMessage
+		err  error
+	)
+	if ttl := c.cache.ttl(request); ttl > 0 {
+		var result cacheResult
+		data, result, err = c.cache.get(ctx, request, ttl, func(ctx context.Context) (json.RawMessage, error) {
+			return c.handle(ctx, request)
+		})
+		recordCache(ctx, result)
+	} else {
+		data, err = c.handle(ctx, request)
+	}
+	if err != nil {
+		return err
+	}
+
+	if responseData != nil && data != nil {
+		err = decode(data, responseData)
+		if err != nil {
+			log.Err(err).Str("method", request.Method).Str("endpoint", helpers.RedactPath(request.Path)).Msg("error while decoding response")
+			return err
+		}
+
+		if response, ok := c.loggable(responseData); ok {
+			log.Info().Str("method", request.Method).Str("endpoint", helpers.RedactPath(request.Path)).Interface(model.LogStrResponse, response).Msg("response")
+		}
+	}
+	return nil
+}
+
+// handle sends a request through the middleware chain and returns the data of the response
+func (c *Call) handle(ctx context.Context, request *Request) (json.RawMessage, error) {
+	// the circuit breaker tells the timeouts of the caller from those set below
+	ctx = context.WithValue(ctx, model.CallerContextKey, ctx)
+	ctx, cancel := c.withTimeout(ctx, request)
+	defer cancel()
+
+	genericResponse, err := c.chain(c.do)(ctx, request)
+	if err != nil || genericResponse == nil {
+		return nil, err
+	}
+	return genericResponse.Data, nil
+}
+
+// do is the innermost handler of the middleware chain, it sends the request and retries it following the retry policy
+func (c *Call) do(ctx context.Context, request *Request) (*model.GenericResponse, error) {
+	endpoint := fmt.Sprintf("%s%s", c.baseURL, request.Path)
+
+	log := c.logger.With().
+		Str(model.LogRequestID, helpers.GetRequestID(ctx)).
+		Str("method", request.Method).
+		Str("endpoint", c.baseURL+helpers.RedactPath(request.Path)).
+		Logger()
 	log.Info().Msg("starting...")
 
+	if body, ok := c.loggable(request.Body); ok {
+		log.Info().Interface(model.LogStrRequest, body).Msg("request")
+	}
+	if params, ok := c.loggable(request.Params); ok {
+		log.Info().Interface(model.LogStrParams, params).Msg("parameters")
+	}
+	if formData, ok := c.loggable(request.FormData); ok {
+		log.Info().Interface(model.LogStrForm, formData).Msg("form data")
+	}
+
 	var (
 		err             error
 		res             *resty.Response
-		genericResponse = model.GenericResponse{}
+		genericResponse model.GenericResponse
+		policy          = c.retryPolicyFor(ctx)
+		replayable      = isReplayable(request.Method, request.FormData, hasStableIdempotencyKey(ctx, request))
+		attempt         int
+		refreshed       bool
+		start           = time.Now()
 	)
 
-	client := c.client.R().
-		SetAuthToken(c.bearerToken).
-		SetHeader(model.RequestIDHeaderKey, helpers.GetRequestID(ctx)).
-		SetResult(&genericResponse).
-		SetError(&genericResponse).
-		SetContext(ctx)
+	if info := responseInfoFrom(ctx); info != nil {
+		defer func() {
+			info.record(res, helpers.GetRequestID(ctx), attempt, time.Since(start))
+		}()
+	}
 
-	if signature != nil {
-		client = client.SetHeader("Signature", *signature)
+	for attempt = 1; ; attempt++ {
+		credentials, credentialsErr := c.currentCredentials(ctx)
+		if credentialsErr != nil {
+			err = credentialsErr
+			log.Err(err).Int("attempt", attempt).Msg("error while making request")
+			return nil, err
+		}
+
+		genericResponse = model.GenericResponse{}
+		res, err = c.attempt(ctx, log, request, endpoint, attempt, credentials, &genericResponse)
+		if err == nil {
+			break
+		}
+
+		// a request refused as unauthenticated was not processed, so it is sent again whatever its method.
+		// A 403 is not retried, since the credentials were accepted but lack the permission
+		if !refreshed && res != nil && res.StatusCode() == http.StatusUnauthorized && len(request.FormData) == 0 {
+			refreshed = true
+			if c.refreshCredentials(ctx, log, credentials) {
+				log.Warn().Err(err).Int("attempt", attempt).Msg("retrying request with refreshed credentials")
+				continue
+			}
+		}
+
+		if attempt >= policy.MaxAttempts ||
+			!isRetryableError(err) ||
+			!replayable {
+			log.Err(err).Int("attempt", attempt).Msg("error while making request")
+			return nil, err
+		}
+
+		wait := policy.backoff(attempt, retryAfter(res))
+		log.Warn().Err(err).Int("attempt", attempt).Dur("backoff", wait).Msg("retrying request")
+		if sleepErr := sleep(ctx, wait); sleepErr != nil {
+			log.Err(err).Int("attempt", attempt).Msg("context done while waiting to retry")
+			return nil, err
+		}
 	}
 
-	if requestBody != nil {
-		log.Info().Interface(model.LogStrRequest, requestBody).Msg("request")
-		client.SetBody(requestBody)
+	log.Info().Int("attempt", attempt).Msg("request completed")
+	return &genericResponse, nil
+}
+
+// attempt sends the request once and reports the outcome to the metrics recorder and the span of the call
+func (c *Call) attempt(ctx context.Context, log zerolog.Logger, request *Request, endpoint string, attempt int, credentials Credentials, genericResponse *model.GenericResponse) (*resty.Response, error) {
+	metrics := c.metrics
+	if metrics == nil {
+		metrics = noopMetrics{}
 	}
 
-	if params != nil {
-		log.Info().Interface(model.LogStrParams, params).Msg("parameters")
-		for k, v := range params {
-			client.SetQueryParam(k, v.(string))
+	circuit := c.breakerFor(request.Group)
+	if circuit != nil {
+		if err := circuit.allow(request.Group); err != nil {
+			log.Err(err).Str("group", string(request.Group)).Msg("circuit breaker is open")
+			return nil, err
 		}
 	}
 
-	if formData != nil {
-		log.Info().Interface(model.LogStrForm, formData).Msg("form data")
-		formDataConv := make(map[string]string)
-		for k, v := range formData {
-			if file, ok := v.(*os.File); ok {
-				name := file.Name()
-				contentType := mime.TypeByExtension(filepath.Ext(name))
-				client.SetMultipartField(k, name, contentType, file)
-			} else {
-				formDataConv[k] = v.(string)
-			}
+	wait, release, err := c.acquire(ctx, request.Group)
+	if wait > time.Millisecond {
+		log.Info().Str("group", string(request.Group)).Dur("wait", wait).Msg("waited for rate limit")
+	}
+	if err != nil {
+		log.Err(err).Str("group", string(request.Group)).Msg("gave up waiting for rate limit")
+		if circuit != nil {
+			circuit.record(outcomeIgnored)
 		}
-		client.SetFormData(formDataConv)
+		return nil, err
+	}
+	defer release()
+
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+
+	client := c.newRequest(ctx, request, credentials, genericResponse)
+	if body, ok := client.Body.(io.Closer); ok {
+		defer body.Close()
+	}
+
+	metrics.IncInFlight(request.Operation)
+	start := time.Now()
+	res, err := send(log, client, request.Method, endpoint, c.baseURL+helpers.RedactPath(request.Path), genericResponse)
+	metrics.DecInFlight(request.Operation)
+	if circuit != nil {
+		circuit.record(outcomeOf(ctx, err))
+	}
+
+	observation := RequestObservation{
+		Operation:  request.Operation,
+		BusinessID: c.businessID,
+		ErrorClass: classifyError(err),
+		Duration:   time.Since(start),
+	}
+	if res != nil {
+		observation.StatusCode = res.StatusCode()
+	}
+	var apiErr *model.APIError
+	if errors.As(err, &apiErr) {
+		observation.ErrorID = apiErr.ID
+	}
+
+	metrics.ObserveRequest(observation)
+	recordAttempt(ctx, attempt, observation.StatusCode)
+
+	return res, err
+}
+
+// newRequest builds the resty request for a single attempt
+func (c *Call) newRequest(ctx context.Context, request *Request, credentials Credentials, genericResponse *model.GenericResponse) *resty.Request {
+	client := c.client.R().
+		SetAuthToken(credentials.BearerToken).
+		SetHeader(model.RequestIDHeaderKey, helpers.GetRequestID(ctx)).
+		SetResult(genericResponse).
+		SetError(genericResponse).
+		SetContext(ctx).
+		SetDebug(c.debug)
+
+	if c.userAgent != "" {
+		client.SetHeader("User-Agent", c.userAgent)
+	}
+
+	if request.SignedReference != nil {
+		client.SetHeader("Signature", helpers.GetSignatureFromReferenceAndPubKey(*request.SignedReference, credentials.APISecret))
+	}
+
+	for k, v := range request.Header {
+		client.Header[k] = v
+	}
+
+	if c.propagator != nil {
+		c.propagator.Inject(ctx, propagation.HeaderCarrier(client.Header))
+	}
+
+	if request.Body != nil {
+		client.SetBody(request.Body)
+	}
+
+	for k, v := range request.Params {
+		client.SetQueryParam(k, v.(string))
 	}
 
+	if request.FormData != nil {
+		body, contentType := multipartBody(request.FormData)
+		client.SetHeader("Content-Type", contentType).SetBody(body)
+	}
+
+	return client
+}
+
+// send executes the request and turns transport failures and API error responses into errors. Transport errors carry
+// loggedEndpoint instead of the endpoint, so that they can be logged
+func send(log zerolog.Logger, client *resty.Request, method, endpoint, loggedEndpoint string, genericResponse *model.GenericResponse) (*resty.Response, error) {
+	var (
+		err error
+		res *resty.Response
+	)
+
 	switch method {
 	case http.MethodGet:
 		res, err = client.Get(endpoint)
@@ -85,87 +335,43 @@ func (c *Call) makeRequest(ctx context.Context, path, method string, signature *
 	default:
 		err = errors.New("invalid method")
 		log.Err(err).Str("method", method).Msg("invalid method passed")
-		return err
+		return nil, err
 	}
 
 	if err != nil {
-		log.Err(err).Msg("something went wrong")
-		if res != nil {
-			log.Err(err).Str("error_code", fmt.Sprintf("%d", res.StatusCode())).Msg(string(res.Body()))
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			urlErr.URL = loggedEndpoint
 		}
-		return err
+		return res, &model.NetworkError{RequestID: client.Header.Get(model.RequestIDHeaderKey), Err: err}
 	}
 
-	if genericResponse.Error != nil {
-		err = errors.New(genericResponse.Error.Details)
-		log.Err(err).Msg("error while making request")
-		return err
+	requestID := client.Header.Get(model.RequestIDHeaderKey)
+	if echoed := res.Header().Get(model.RequestIDHeaderKey); echoed != "" && echoed != requestID {
+		log.Info().Str("server_request_id", echoed).Msg("api echoed a different request id")
+		requestID = echoed
 	}
 
-	log.Info().Interface(model.LogStrResponse, genericResponse.Data).Msg("response")
-	if responseData != nil {
-		err = mapstruct(genericResponse.Data, responseData)
-		if err != nil {
-			return err
-		}
+	if genericResponse.Error != nil || res.IsError() {
+		apiErr := model.NewAPIError(res.StatusCode(), genericResponse.Error, res.Body())
+		apiErr.RequestID = requestID
+		log.Err(apiErr).
+			Int(model.LogErrorCode, apiErr.StatusCode).
+			Str("error_id", apiErr.ID).
+			Msg("api returned an error")
+		return res, apiErr
 	}
-	return nil
-}
 
-// mapstruct map api call result to the expected interface
-func mapstruct(data, v interface{}) error {
-	config := &mapstructure.DecoderConfig{
-		Result:           v,
-		TagName:          "json",
-		WeaklyTypedInput: true,
-		DecodeHook: mapstructure.ComposeDecodeHookFunc(
-			stringToTimeHookFunc(time.RFC3339Nano),
-			stringToUUIDHookFunc(),
-		),
-	}
-
-	decoder, err := mapstructure.NewDecoder(config)
-	if err != nil {
-		return err
-	}
-
-	err = decoder.Decode(data)
-
-	return err
+	return res, nil
 }
 
-// stringToUUIDHookFunc type conversion for string to uuid.UUID
-func stringToUUIDHookFunc() mapstructure.DecodeHookFunc {
-	return func(
-		f reflect.Type,
-		t reflect.Type,
-		data interface{}) (interface{}, error) {
-		if f.Kind() == reflect.String && t == reflect.TypeOf(uuid.UUID{}) {
-			str, ok := data.(string)
-			if !ok {
-				return data, nil
-			}
-			return uuid.Parse(str)
-		}
-		return data, nil
+// decode unmarshals the data of an API response into v, leaving v untouched when the API sent no data
+func decode(data json.RawMessage, v interface{}) error {
+	if len(data) == 0 || string(data) == "null" {
+		return nil
 	}
-}
-
-// stringToTimeHookFunc type conversion for string to time.Time
-func stringToTimeHookFunc(layout string) mapstructure.DecodeHookFunc {
-	return func(
-		f reflect.Type,
-		t reflect.Type,
-		data interface{}) (interface{}, error) {
-		if f.Kind() != reflect.String || t != reflect.TypeOf(time.Time{}) {
-			return data, nil
-		}
-
-		str, ok := data.(string)
-		if !ok {
-			return data, nil
-		}
-
-		return time.Parse(layout, str)
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("decoding response data: %w", err)
 	}
+	return nil
 }
@@ -40,13 +40,13 @@ func Test_makeRequest(t *testing.T) {
 				firstName := query.Get("first_name")
 				lastName := query.Get("last_name")
 				body, err := json.Marshal(model.GenericResponse{
-					Data: struct {
+					Data: rawJSON(t, struct {
 						Name string `json:"name"`
 					}{
 						Name: func(firstName, lastName string) string {
 							return fmt.Sprintf("%s %s", firstName, lastName)
 						}(firstName, lastName),
-					},
+					}),
 				})
 				assert.NoError(t, err)
 
@@ -77,11 +77,11 @@ func Test_makeRequest(t *testing.T) {
 				assert.NoError(t, err)
 
 				body, err := json.Marshal(model.GenericResponse{
-					Data: struct {
+					Data: rawJSON(t, struct {
 						Message string `json:"message"`
 					}{
 						Message: "User registered successfully!",
-					},
+					}),
 				})
 				assert.NoError(t, err)
 
@@ -108,7 +108,7 @@ func Test_makeRequest(t *testing.T) {
 				assert.NoError(t, err)
 
 				body, err := json.Marshal(model.GenericResponse{
-					Data: true,
+					Data: rawJSON(t, true),
 				})
 				assert.NoError(t, err)
 
@@ -156,7 +156,7 @@ func Test_makeRequest(t *testing.T) {
 					"first_name": "John",
 					"last_name":  "Doe",
 				}
-				err := c.makeRequest(ctx, "/name", http.MethodGet, nil, params, nil, nil, &response)
+				err := c.makeRequest(ctx, "test", "/name", http.MethodGet, nil, params, nil, nil, &response)
 				assert.Equal(t, tt.expectedResult, response)
 				assert.Equal(t, tt.expectedErr, err)
 			} else if tt.requestPath == "/register" {
@@ -170,7 +170,7 @@ func Test_makeRequest(t *testing.T) {
 					FirstName: "John",
 					LastName:  "Doe",
 				}
-				err := c.makeRequest(ctx, "/register", http.MethodPost, nil, nil, nil, request, &response)
+				err := c.makeRequest(ctx, "test", "/register", http.MethodPost, nil, nil, nil, request, &response)
 				assert.Equal(t, tt.expectedResult, response)
 				assert.Equal(t, tt.expectedErr, err)
 			} else if tt.requestPath == "/update" {
@@ -182,52 +182,124 @@ func Test_makeRequest(t *testing.T) {
 					FirstName: "John",
 					LastName:  "Doe",
 				}
-				err := c.makeRequest(ctx, "/update", http.MethodPut, nil, nil, nil, request, &response)
+				err := c.makeRequest(ctx, "test", "/update", http.MethodPut, nil, nil, nil, request, &response)
 				assert.Equal(t, tt.expectedResult, response)
 				assert.Equal(t, tt.expectedErr, err)
 			} else if tt.requestPath == "/error" {
 				var response struct{} // not needed anyway
-				err := c.makeRequest(ctx, "/error", http.MethodGet, nil, nil, nil, nil, &response)
-				assert.Equal(t, tt.expectedErr, err)
+				err := c.makeRequest(ctx, "test", "/error", http.MethodGet, nil, nil, nil, nil, &response)
+				assert.EqualError(t, err, tt.expectedErr.Error())
+				assert.ErrorIs(t, err, model.ErrUnauthorized)
 			}
 		})
 	}
 }
 
-func Test_mapstructUUIDHook(t *testing.T) {
-	id := "123e4567-e89b-12d3-a456-426614174000"
-	name := "John Doe"
-	data := map[string]interface{}{
-		"id":   id,
-		"name": name,
+func Test_makeRequestAPIError(t *testing.T) {
+	tests := map[string]struct {
+		statusCode int
+		body       string
+		sentinel   error
+		expected   model.APIError
+	}{
+		"not found": {
+			statusCode: http.StatusNotFound,
+			body:       `{"error":{"id":"customer_not_found","details":"customer not found","message":"not found"}}`,
+			sentinel:   model.ErrNotFound,
+			expected:   model.APIError{StatusCode: http.StatusNotFound, ID: "customer_not_found", Details: "customer not found", Message: "not found"},
+		},
+		"duplicate reference": {
+			statusCode: http.StatusBadRequest,
+			body:       `{"error":{"id":"bad_request","details":"duplicate reference","message":"bad request"}}`,
+			sentinel:   model.ErrDuplicateReference,
+			expected:   model.APIError{StatusCode: http.StatusBadRequest, ID: "bad_request", Details: "duplicate reference", Message: "bad request"},
+		},
+		"insufficient balance": {
+			statusCode: http.StatusBadRequest,
+			body:       `{"error":{"id":"insufficient_balance","details":"insufficient balance in USD wallet"}}`,
+			sentinel:   model.ErrInsufficientBalance,
+			expected:   model.APIError{StatusCode: http.StatusBadRequest, ID: "insufficient_balance", Details: "insufficient balance in USD wallet"},
+		},
+		"validation failure": {
+			statusCode: http.StatusUnprocessableEntity,
+			body:       `{"error":{"id":"validation_error","details":"amount is required"}}`,
+			sentinel:   model.ErrValidation,
+			expected:   model.APIError{StatusCode: http.StatusUnprocessableEntity, ID: "validation_error", Details: "amount is required"},
+		},
+		"non json gateway error": {
+			statusCode: http.StatusBadGateway,
+			body:       `<html>bad gateway</html>`,
+			expected:   model.APIError{StatusCode: http.StatusBadGateway},
+		},
 	}
-	type User struct {
-		ID   uuid.UUID `json:"id"`
-		Name string    `json:"name"`
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.statusCode)
+				_, err := w.Write([]byte(tt.body))
+				assert.NoError(t, err)
+			}))
+			defer ts.Close()
+
+			c := &Call{
+				baseURL: ts.URL,
+				client:  resty.New(),
+				logger:  zerolog.Nop(),
+			}
+			err := c.makeRequest(context.Background(), "test", "/error", http.MethodGet, nil, nil, nil, nil, nil)
+
+			var apiErr *model.APIError
+			assert.True(t, errors.As(err, &apiErr))
+			assert.Equal(t, tt.expected.StatusCode, apiErr.StatusCode)
+			assert.Equal(t, tt.expected.ID, apiErr.ID)
+			assert.Equal(t, tt.expected.Details, apiErr.Details)
+			assert.Equal(t, tt.expected.Message, apiErr.Message)
+			assert.Equal(t, tt.body, string(apiErr.Body))
+			if tt.sentinel != nil {
+				assert.ErrorIs(t, err, tt.sentinel)
+			}
+		})
 	}
-	var user User
-	err := mapstruct(data, &user)
-	assert.NoError(t, err)
-	assert.Equal(t, uuid.MustParse(id), user.ID)
-	assert.Equal(t, name, user.Name)
 }
 
-func Test_mapstructTimeHook(t *testing.T) {
+func Test_decode(t *testing.T) {
+	id := "123e4567-e89b-12d3-a456-426614174000"
 	createdAt := time.Now()
-	name := "John Doe"
-	data := map[string]interface{}{
-		"name":       name,
-		"created_at": createdAt.Format(time.RFC3339Nano),
-	}
 	type User struct {
-		Name      string    `json:"name"`
-		CreatedAt time.Time `json:"created_at"`
+		ID        uuid.UUID  `json:"id"`
+		Name      string     `json:"name"`
+		CreatedAt time.Time  `json:"created_at"`
+		DeletedAt *time.Time `json:"deleted_at"`
 	}
+
 	var user User
-	err := mapstruct(data, &user)
+	err := decode(rawJSON(t, map[string]interface{}{
+		"id":         id,
+		"name":       "John Doe",
+		"created_at": createdAt.Format(time.RFC3339Nano),
+		"deleted_at": nil,
+	}), &user)
 	assert.NoError(t, err)
-	if !createdAt.Equal(user.CreatedAt) {
-		t.Errorf("Expected: %v, \nActual: %v", createdAt, user.CreatedAt)
-	}
-	assert.Equal(t, name, user.Name)
+	assert.Equal(t, uuid.MustParse(id), user.ID)
+	assert.Equal(t, "John Doe", user.Name)
+	assert.True(t, createdAt.Equal(user.CreatedAt))
+	assert.Nil(t, user.DeletedAt)
+
+	user = User{Name: "untouched"}
+	assert.NoError(t, decode(nil, &user))
+	assert.NoError(t, decode(json.RawMessage("null"), &user))
+	assert.Equal(t, "untouched", user.Name)
+
+	err = decode(json.RawMessage(`{"name":42}`), &user)
+	var typeErr *json.UnmarshalTypeError
+	assert.ErrorAs(t, err, &typeErr)
+}
+
+// rawJSON marshals v to be used as the data of a GenericResponse
+func rawJSON(t *testing.T, v interface{}) json.RawMessage {
+	t.Helper()
+	data, err := json.Marshal(v)
+	assert.NoError(t, err)
+	return data
 }
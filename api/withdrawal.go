@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"net/http"
 
-	"github.com/ovalfi/go-sdk/helpers"
 	"github.com/ovalfi/go-sdk/model"
 )
 
@@ -17,10 +16,10 @@ func (c *Call) InitiateWithdrawal(ctx context.Context, request model.WithdrawalR
 		err       error
 		response  model.Withdrawal
 		path      = withdrawalAPIVersion
-		signature = helpers.GetSignatureFromReferenceAndPubKey(request.Reference, c.apiSecret)
+		reference = request.Reference
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodPost, &signature, nil, nil, request, &response)
+	err = c.makeRequest(ctx, "InitiateWithdrawal", path, http.MethodPost, &reference, nil, nil, request, &response)
 
 	return response, err
 }
@@ -31,10 +30,10 @@ func (c *Call) FiatWithdrawal(ctx context.Context, request model.WithdrawalReque
 		err       error
 		response  model.Withdrawal
 		path      = fmt.Sprintf("%s/fiat", withdrawalAPIVersion)
-		signature = helpers.GetSignatureFromReferenceAndPubKey(request.Reference, c.apiSecret)
+		reference = request.Reference
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodPost, &signature, nil, nil, request, &response)
+	err = c.makeRequest(ctx, "FiatWithdrawal", path, http.MethodPost, &reference, nil, nil, request, &response)
 
 	return response, err
 }
@@ -45,10 +44,10 @@ func (c *Call) CryptoWithdrawal(ctx context.Context, request model.WithdrawalReq
 		err       error
 		response  model.Withdrawal
 		path      = fmt.Sprintf("%s/crypto", withdrawalAPIVersion)
-		signature = helpers.GetSignatureFromReferenceAndPubKey(request.Reference, c.apiSecret)
+		reference = request.Reference
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodPost, &signature, nil, nil, request, &response)
+	err = c.makeRequest(ctx, "CryptoWithdrawal", path, http.MethodPost, &reference, nil, nil, request, &response)
 
 	return response, err
 }
@@ -59,10 +58,10 @@ func (c *Call) FeeWithdrawal(ctx context.Context, request model.FeeWithdrawalReq
 		err       error
 		response  model.FeeWithdrawalResponse
 		path      = fmt.Sprintf("%s/fee", withdrawalAPIVersion)
-		signature = helpers.GetSignatureFromReferenceAndPubKey(request.Reference, c.apiSecret)
+		reference = request.Reference
 	)
 
-	err = c.makeRequest(ctx, path, http.MethodPost, &signature, nil, nil, request, &response)
+	err = c.makeRequest(ctx, "FeeWithdrawal", path, http.MethodPost, &reference, nil, nil, request, &response)
 
 	return response, err
 }
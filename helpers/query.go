@@ -0,0 +1,84 @@
+package helpers
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/ovalfi/go-sdk/model"
+)
+
+// QueryTag is the struct tag naming the query parameter a filter field is sent as, e.g. `query:"customer_id"`
+const QueryTag = "query"
+
+var (
+	dateIntervalType = reflect.TypeOf((*model.DateInterval)(nil)).Elem()
+	pageType         = reflect.TypeOf(model.Page{})
+)
+
+// FillParamsWithFilter fills the parameters map with the fields of a filter struct tagged `query`. Empty fields and nil
+// pointers are left out, while a set pointer is sent even to a zero value. model.DateInterval and model.Page fields are
+// filled like FillParamsWithDateInterval and FillParamsWithPage do, and an invalid date interval fails
+func FillParamsWithFilter(params map[string]interface{}, filter interface{}) error {
+	v := reflect.Indirect(reflect.ValueOf(filter))
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		value := v.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		if field.Type.Implements(dateIntervalType) {
+			if (value.Kind() == reflect.Interface || value.Kind() == reflect.Ptr) && value.IsNil() {
+				continue
+			}
+			if err := FillParamsWithDateInterval(params, value.Interface().(model.DateInterval)); err != nil {
+				return fmt.Errorf("%s: %w", field.Name, err)
+			}
+			continue
+		}
+
+		pointer := value.Kind() == reflect.Ptr
+		if pointer {
+			if value.IsNil() {
+				continue
+			}
+			value = value.Elem()
+		}
+		if value.Type() == pageType {
+			FillParamsWithPage(params, value.Interface().(model.Page))
+			continue
+		}
+
+		name, ok := field.Tag.Lookup(QueryTag)
+		if !ok || name == "-" || (!pointer && value.IsZero()) {
+			continue
+		}
+		params[name] = formatQueryValue(value)
+	}
+	return nil
+}
+
+// formatQueryValue formats a filter value the way the API expects it in a query string
+func formatQueryValue(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 32)
+	case reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64)
+	default:
+		return fmt.Sprint(v.Interface())
+	}
+}
@@ -0,0 +1,63 @@
+package helpers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ovalfi/go-sdk/model"
+)
+
+func TestFillParamsWithFilter(t *testing.T) {
+	type filter struct {
+		Status   string   `query:"status"`
+		Search   string   `query:"search"`
+		Amount   *float64 `query:"amount"`
+		Fee      *float64 `query:"fee"`
+		Count    int      `query:"count"`
+		Settled  bool     `query:"settled"`
+		Ignored  string   `query:"-"`
+		Untagged string
+		Dates    model.DateInterval
+		Page     model.Page
+	}
+
+	params := make(map[string]interface{})
+	err := FillParamsWithFilter(params, filter{
+		Status:   "completed",
+		Amount:   GetPointerFloat64(0),
+		Count:    3,
+		Settled:  true,
+		Ignored:  "ignored",
+		Untagged: "untagged",
+		Dates:    &model.DateBetween{From: "2024-01-01"},
+		Page:     model.Page{Number: GetPointerInt(2)},
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, map[string]interface{}{
+		"status":  "completed",
+		"amount":  "0",
+		"count":   "3",
+		"settled": "true",
+		"from":    "2024-01-01",
+		"number":  "2",
+	}, params)
+}
+
+func TestFillParamsWithFilterTransaction(t *testing.T) {
+	params := make(map[string]interface{})
+	err := FillParamsWithFilter(params, &model.TransactionFilter{
+		CustomerID: "customer-1",
+		Amount:     GetPointerFloat64(1500.5),
+		Dates:      (*model.DateBetween)(nil),
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"customer_id": "customer-1", "amount": "1500.5"}, params)
+
+	err = FillParamsWithFilter(params, model.TransactionFilter{
+		Dates: model.DateRange{From: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), To: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+	})
+	require.ErrorIs(t, err, model.ErrValidation)
+}
@@ -0,0 +1,205 @@
+package helpers
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+)
+
+const (
+	// SensitiveTag is the struct tag marking a field that must never be logged in clear, e.g. `sensitive:"pan"`
+	SensitiveTag = "sensitive"
+
+	// SensitivePAN is the sensitive tag value of card numbers, which are masked down to their last four digits
+	SensitivePAN = "pan"
+
+	// RedactedValue replaces the value of sensitive fields
+	RedactedValue = "[REDACTED]"
+)
+
+// sensitiveKeys are the map keys redacted in untyped payloads such as query parameters and interface{} responses
+var sensitiveKeys = map[string]string{
+	"full_pan":        SensitivePAN,
+	"pan":             SensitivePAN,
+	"cvv":             "cvv",
+	"bvn":             "bvn",
+	"ssn":             "ssn",
+	"nonce_key":       "secret",
+	"session_secret":  "secret",
+	"session_token":   "secret",
+	"ephemeral_key":   "secret",
+	"apple_token":     "token",
+	"google_token":    "token",
+	"auth_code":       "secret",
+	"document_number": "document",
+	"id_number":       "document",
+	"tax_id_number":   "document",
+}
+
+// sensitivePaths are the API paths carrying personal data in one of their segments. They are matched against the end of
+// a path, "*" matching any segment and "!" marking the segment redacted
+var sensitivePaths = [][]string{
+	// VerifyCustomerKYC sends the identity number in the path
+	{"v1", "kycs", "*", "*", "!"},
+}
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// Redact returns a copy of v that is safe to log: fields tagged `sensitive` and well-known sensitive map keys are masked,
+// files and streams are replaced by a short description. Structs are returned as maps keyed by their JSON names
+func Redact(v interface{}) interface{} {
+	if v == nil {
+		return nil
+	}
+	return redactValue(reflect.ValueOf(v))
+}
+
+// RedactPath returns a copy of an API path that is safe to log, with the segments carrying personal data masked.
+// The path may be prefixed, e.g. by the path of the base URL
+func RedactPath(path string) string {
+	segments := strings.Split(path, "/")
+	for _, pattern := range sensitivePaths {
+		offset := len(segments) - len(pattern)
+		if offset < 0 || !matchesPath(segments[offset:], pattern) {
+			continue
+		}
+		for i, segment := range pattern {
+			if segment == "!" && segments[offset+i] != "" {
+				segments[offset+i] = RedactedValue
+			}
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+func matchesPath(segments, pattern []string) bool {
+	for i, segment := range pattern {
+		if segment != "*" && segment != "!" && segment != segments[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// MaskPAN masks a card number down to its last four digits
+func MaskPAN(pan string) string {
+	if len(pan) <= 4 {
+		return strings.Repeat("*", len(pan))
+	}
+	return strings.Repeat("*", len(pan)-4) + pan[len(pan)-4:]
+}
+
+func redactValue(v reflect.Value) interface{} {
+	if !v.IsValid() {
+		return nil
+	}
+
+	switch value := v.Interface().(type) {
+	case *os.File:
+		if value == nil {
+			return nil
+		}
+		return fmt.Sprintf("[file %s]", value.Name())
+	case io.Reader:
+		return "[stream]"
+	case []byte:
+		return fmt.Sprintf("[%d bytes]", len(value))
+	}
+
+	if v.Type().Implements(jsonMarshalerType) || v.Type().Implements(textMarshalerType) {
+		return v.Interface()
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return redactValue(v.Elem())
+	case reflect.Struct:
+		out := make(map[string]interface{})
+		redactStruct(v, out)
+		return out
+	case reflect.Map:
+		if v.IsNil() {
+			return nil
+		}
+		out := make(map[string]interface{}, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			key := fmt.Sprint(iter.Key().Interface())
+			if kind, ok := sensitiveKeys[strings.ToLower(key)]; ok {
+				out[key] = mask(iter.Value(), kind)
+				continue
+			}
+			out[key] = redactValue(iter.Value())
+		}
+		return out
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return nil
+		}
+		out := make([]interface{}, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			out[i] = redactValue(v.Index(i))
+		}
+		return out
+	default:
+		return v.Interface()
+	}
+}
+
+// redactStruct writes the exported fields of a struct into out, flattening embedded structs like encoding/json does
+func redactStruct(v reflect.Value, out map[string]interface{}) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+
+		value := v.Field(i)
+		if field.Anonymous && name == "" && value.Kind() == reflect.Struct {
+			redactStruct(value, out)
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		if kind, ok := field.Tag.Lookup(SensitiveTag); ok {
+			out[name] = mask(value, kind)
+			continue
+		}
+		out[name] = redactValue(value)
+	}
+}
+
+// mask hides a sensitive value, leaving empty values untouched so that their absence is still visible
+func mask(v reflect.Value, kind string) interface{} {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.IsZero() {
+		return v.Interface()
+	}
+	if kind == SensitivePAN && v.Kind() == reflect.String {
+		return MaskPAN(v.String())
+	}
+	return RedactedValue
+}
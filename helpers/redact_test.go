@@ -0,0 +1,102 @@
+package helpers
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ovalfi/go-sdk/model"
+)
+
+func TestRedact(t *testing.T) {
+	file, err := os.Create(filepath.Join(t.TempDir(), "passport.png"))
+	require.NoError(t, err)
+	defer file.Close()
+
+	tests := map[string]struct {
+		input    interface{}
+		expected interface{}
+	}{
+		"vaulted card details": {
+			input: model.VaultedCardDetails{FullPAN: "4111111111111111", CVV: "123", ExpiryDate: "09", NameOnCard: "Ada"},
+			expected: map[string]interface{}{
+				"full_pan":      "************1111",
+				"cvv":           RedactedValue,
+				"expiry_date":   "09",
+				"name_on_card":  "Ada",
+				"issuer":        "",
+				"ephemeral_key": "",
+			},
+		},
+		"pointer fields and empty values": {
+			input: &model.InitiateCardRequest{CustomerID: "c1", SSN: GetPointerString("1234")},
+			expected: map[string]interface{}{
+				"customer_id":   "c1",
+				"reference":     "",
+				"date_of_birth": "",
+				"ssn":           RedactedValue,
+				"phone":         "",
+				"address":       "",
+				"city":          "",
+				"state":         "",
+				"postal_code":   "",
+				"ip_address":    "",
+				"redirect_uri":  "",
+			},
+		},
+		"untyped map": {
+			input: map[string]interface{}{"customer_id": "c1", "nonce_key": "n0nce", "full_pan": "5555444433332222"},
+			expected: map[string]interface{}{
+				"customer_id": "c1",
+				"nonce_key":   RedactedValue,
+				"full_pan":    "************2222",
+			},
+		},
+		"form data with file": {
+			input: map[string]interface{}{"country": "NG", "document_front_side": file},
+			expected: map[string]interface{}{
+				"country":             "NG",
+				"document_front_side": "[file " + file.Name() + "]",
+			},
+		},
+		"nil": {
+			input:    nil,
+			expected: nil,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, test.expected, Redact(test.input))
+		})
+	}
+}
+
+func TestRedactEmbeddedStruct(t *testing.T) {
+	request := model.CreateCustomerCardRequestV2{
+		CardType: "virtual",
+		GenerateBankAccountRequest: model.GenerateBankAccountRequest{
+			CustomerID: "c1",
+			BVN:        GetPointerString("22222222222"),
+		},
+	}
+
+	redacted := Redact(request).(map[string]interface{})
+	require.Equal(t, "virtual", redacted["card_type"])
+	require.Equal(t, "c1", redacted["customer_id"])
+	require.Equal(t, RedactedValue, redacted["bvn"])
+	require.Nil(t, redacted["document_number"])
+}
+
+func TestMaskPAN(t *testing.T) {
+	require.Equal(t, "************4242", MaskPAN("4242424242424242"))
+	require.Equal(t, "***", MaskPAN("424"))
+}
+
+func TestRedactPath(t *testing.T) {
+	require.Equal(t, "v1/kycs/customer-1/nin/"+RedactedValue, RedactPath("v1/kycs/customer-1/nin/12345678901"))
+	require.Equal(t, "/api/v1/kycs/customer-1/nin/"+RedactedValue, RedactPath("/api/v1/kycs/customer-1/nin/12345678901"))
+	require.Equal(t, "v1/kycs/customer-1", RedactPath("v1/kycs/customer-1"))
+	require.Equal(t, "v1/cards/card-1/secure", RedactPath("v1/cards/card-1/secure"))
+}
@@ -1,9 +1,13 @@
 package helpers
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/ovalfi/go-sdk/model"
 )
 
 func TestGetSignatureFromReferenceAndPubKey(t *testing.T) {
@@ -31,3 +35,79 @@ func TestGetSignatureFromReferenceAndPubKey(t *testing.T) {
 		})
 	}
 }
+
+func TestWithRequestID(t *testing.T) {
+	ctx := context.Background()
+	require.Empty(t, GetRequestID(ctx))
+	require.Equal(t, "req-1", GetRequestID(WithRequestID(ctx, "req-1")))
+}
+
+func TestFillParamsWithDateInterval(t *testing.T) {
+	lagos := time.FixedZone("WAT", 3600)
+	now := time.Date(2024, 3, 5, 14, 30, 15, 0, lagos)
+
+	tests := map[string]struct {
+		interval model.DateInterval
+		expected map[string]interface{}
+	}{
+		"free-form bounds": {
+			interval: model.DateBetween{From: "2024-01-05"},
+			expected: map[string]interface{}{"from": "2024-01-05"},
+		},
+		"inclusive range in UTC": {
+			interval: model.DateRange{From: time.Date(2024, 1, 1, 0, 0, 0, 0, lagos), To: time.Date(2024, 1, 31, 23, 59, 59, 0, lagos)},
+			expected: map[string]interface{}{"from": "2023-12-31T23:00:00Z", "to": "2024-01-31T22:59:59Z"},
+		},
+		"exclusive range": {
+			interval: model.DateRange{From: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), To: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), ExcludeTo: true},
+			expected: map[string]interface{}{"from": "2024-01-01T00:00:00Z", "to": "2024-01-31T23:59:59.999999999Z"},
+		},
+		"sub-second bounds": {
+			interval: model.DateRange{From: time.Date(2024, 1, 1, 0, 0, 0, 500, time.UTC), To: time.Date(2024, 1, 2, 0, 0, 0, 500, time.UTC), ExcludeTo: true},
+			expected: map[string]interface{}{"from": "2024-01-01T00:00:00.0000005Z", "to": "2024-01-02T00:00:00.000000499Z"},
+		},
+		"open range": {
+			interval: model.DateRange{From: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+			expected: map[string]interface{}{"from": "2024-01-01T00:00:00Z"},
+		},
+		"last 7 days": {
+			interval: model.LastDays(now, 7),
+			expected: map[string]interface{}{"from": "2024-02-27T23:00:00Z", "to": "2024-03-05T13:30:15Z"},
+		},
+		"month to date": {
+			interval: model.MonthToDate(now),
+			expected: map[string]interface{}{"from": "2024-02-29T23:00:00Z", "to": "2024-03-05T13:30:15Z"},
+		},
+		"nil": {
+			interval: (*model.DateBetween)(nil),
+			expected: map[string]interface{}{},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			params := make(map[string]interface{})
+			require.NoError(t, FillParamsWithDateInterval(params, tt.interval))
+			require.Equal(t, tt.expected, params)
+		})
+	}
+}
+
+func TestFillParamsWithInvalidDateRange(t *testing.T) {
+	day := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	_, err := model.NewDateRange(day, day.Add(-time.Second))
+	require.ErrorIs(t, err, model.ErrInvalidDateRange)
+	require.ErrorIs(t, err, model.ErrValidation)
+
+	for _, interval := range []model.DateRange{
+		{From: day, To: day.Add(-time.Hour)},
+		{From: day, To: day, ExcludeTo: true},
+	} {
+		params := make(map[string]interface{})
+		require.ErrorIs(t, FillParamsWithDateInterval(params, interval), model.ErrInvalidDateRange)
+		require.Empty(t, params)
+	}
+
+	_, err = model.NewDateRange(day, day)
+	require.NoError(t, err)
+}
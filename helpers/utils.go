@@ -5,6 +5,7 @@ import (
 	"context"
 	"crypto/sha256"
 	"fmt"
+	"reflect"
 	"strconv"
 
 	"github.com/ovalfi/go-sdk/model"
@@ -27,6 +28,12 @@ func GetRequestID(ctx context.Context) string {
 	return ""
 }
 
+// WithRequestID returns a context carrying the request ID sent with the calls made with it.
+// The SDK generates one per call when the context has none
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, model.RequestIDContextKey, requestID)
+}
+
 // GetPointerString get string pointer
 func GetPointerString(s string) *string {
 	return &s
@@ -62,12 +69,25 @@ func FillParamsWithPage(params map[string]interface{}, page model.Page) {
 	}
 }
 
-// FillParamsWithDateInterval fill parameters map with date interval
-func FillParamsWithDateInterval(params map[string]interface{}, dateBetween model.DateBetween) {
-	if dateBetween.From != "" {
-		params["from"] = dateBetween.From
+// FillParamsWithDateInterval fill parameters map with date interval, a model.DateBetween or a typed model.DateRange.
+// It fails when the interval is invalid, e.g. a model.DateRange ending before it starts
+func FillParamsWithDateInterval(params map[string]interface{}, interval model.DateInterval) error {
+	if interval == nil {
+		return nil
+	}
+	if v := reflect.ValueOf(interval); v.Kind() == reflect.Ptr && v.IsNil() {
+		return nil
+	}
+
+	from, to, err := interval.Bounds()
+	if err != nil {
+		return err
+	}
+	if from != "" {
+		params["from"] = from
 	}
-	if dateBetween.To != "" {
-		params["to"] = dateBetween.To
+	if to != "" {
+		params["to"] = to
 	}
+	return nil
 }
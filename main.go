@@ -2,8 +2,8 @@ package main
 
 import (
 	"os"
+	"time"
 
-	"github.com/go-resty/resty/v2"
 	"github.com/rs/zerolog"
 
 	"github.com/ovalfi/go-sdk/api"
@@ -12,10 +12,17 @@ import (
 
 func main() {
 	logger := zerolog.New(os.Stderr).With().Timestamp().Logger()
-	client := resty.New()
 	logger.Info().Msg("app is starting")
 	defer logger.Info().Msg("stopped")
-	apiCalls := api.New(&logger, client, model.APISecret, model.BearerToken, model.BaseURL)
+	apiCalls, err := api.NewClient(
+		api.WithEnvironment(api.Sandbox),
+		api.WithCredentials(model.APISecret, model.BearerToken),
+		api.WithLogger(&logger),
+		api.WithTimeout(30*time.Second),
+	)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("unable to create client")
+	}
 	apiCalls.RunInSandboxMode() // to ensure it is running in sandbox mode
 	//ctx := context.Background()
 
@@ -233,7 +240,7 @@ func main() {
 	//}
 	//fmt.Printf("Customer: %+v\n", customer)
 
-	//customers, err := apiCalls.GetAllCustomers(ctx)
+	//customers, err := apiCalls.ListCustomers(ctx, model.CustomerFilter{}, nil)
 	//if err != nil {
 	//	fmt.Printf("Error: %v\n", err)
 	//	return
@@ -0,0 +1,87 @@
+// Package metrics provides MetricsRecorder implementations for the SDK
+package metrics
+
+import (
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/ovalfi/go-sdk/api"
+)
+
+const (
+	labelOperation  = "operation"
+	labelBusinessID = "business_id"
+	labelStatusCode = "status_code"
+	labelErrorID    = "error_id"
+	labelErrorClass = "error_class"
+)
+
+// Prometheus is an api.MetricsRecorder exposing the SDK measurements as Prometheus collectors
+type Prometheus struct {
+	requests *prometheus.CounterVec
+	latency  *prometheus.HistogramVec
+	inFlight *prometheus.GaugeVec
+	errors   *prometheus.CounterVec
+}
+
+var _ api.MetricsRecorder = (*Prometheus)(nil)
+
+// NewPrometheus creates the SDK collectors under the given namespace and registers them with the registerer
+func NewPrometheus(registerer prometheus.Registerer, namespace string) (*Prometheus, error) {
+	p := &Prometheus{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "ovalfi_sdk",
+			Name:      "requests_total",
+			Help:      "Number of requests sent to the Oval API.",
+		}, []string{labelOperation, labelBusinessID, labelStatusCode}),
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "ovalfi_sdk",
+			Name:      "request_duration_seconds",
+			Help:      "Latency of the requests sent to the Oval API.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{labelOperation, labelBusinessID, labelStatusCode}),
+		inFlight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "ovalfi_sdk",
+			Name:      "requests_in_flight",
+			Help:      "Number of requests to the Oval API waiting for a response.",
+		}, []string{labelOperation}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "ovalfi_sdk",
+			Name:      "errors_total",
+			Help:      "Number of requests to the Oval API that failed.",
+		}, []string{labelOperation, labelBusinessID, labelStatusCode, labelErrorID, labelErrorClass}),
+	}
+
+	for _, collector := range []prometheus.Collector{p.requests, p.latency, p.inFlight, p.errors} {
+		if err := registerer.Register(collector); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// IncInFlight implements api.MetricsRecorder
+func (p *Prometheus) IncInFlight(operation string) {
+	p.inFlight.WithLabelValues(operation).Inc()
+}
+
+// DecInFlight implements api.MetricsRecorder
+func (p *Prometheus) DecInFlight(operation string) {
+	p.inFlight.WithLabelValues(operation).Dec()
+}
+
+// ObserveRequest implements api.MetricsRecorder
+func (p *Prometheus) ObserveRequest(observation api.RequestObservation) {
+	statusCode := strconv.Itoa(observation.StatusCode)
+
+	p.requests.WithLabelValues(observation.Operation, observation.BusinessID, statusCode).Inc()
+	p.latency.WithLabelValues(observation.Operation, observation.BusinessID, statusCode).Observe(observation.Duration.Seconds())
+	if observation.ErrorClass != "" {
+		p.errors.WithLabelValues(observation.Operation, observation.BusinessID, statusCode, observation.ErrorID, observation.ErrorClass).Inc()
+	}
+}
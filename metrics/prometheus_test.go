@@ -0,0 +1,69 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ovalfi/go-sdk/api"
+	"github.com/ovalfi/go-sdk/model"
+)
+
+func TestPrometheus(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/v1/bills/pay" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":{"id":"insufficient_balance","details":"insufficient balance"}}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"data":{"rate":1500}}`))
+	}))
+	defer ts.Close()
+
+	registry := prometheus.NewRegistry()
+	recorder, err := NewPrometheus(registry, "test")
+	require.NoError(t, err)
+
+	client, err := api.NewClient(
+		api.WithCredentials("secret", "token"),
+		api.WithBaseURL(ts.URL+"/"),
+		api.WithMetrics(recorder),
+	)
+	require.NoError(t, err)
+
+	_, err = client.GetExchangeRates(context.Background(), 100, "USD", "NGN")
+	require.NoError(t, err)
+	_, err = client.PayBill(context.Background(), model.PayBillRequest{Code: "mtn-100", Amount: 100})
+	require.Error(t, err)
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(recorder.requests.WithLabelValues("GetExchangeRates", "", "200")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(recorder.requests.WithLabelValues("PayBill", "", "400")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(recorder.errors.WithLabelValues("PayBill", "", "400", "insufficient_balance", "insufficient_balance")))
+	assert.Equal(t, 0.0, testutil.ToFloat64(recorder.inFlight.WithLabelValues("GetExchangeRates")))
+
+	expected := `
+		# HELP test_ovalfi_sdk_errors_total Number of requests to the Oval API that failed.
+		# TYPE test_ovalfi_sdk_errors_total counter
+		test_ovalfi_sdk_errors_total{business_id="",error_class="insufficient_balance",error_id="insufficient_balance",operation="PayBill",status_code="400"} 1
+	`
+	assert.NoError(t, testutil.GatherAndCompare(registry, strings.NewReader(expected), "test_ovalfi_sdk_errors_total"))
+	assert.Equal(t, 2, testutil.CollectAndCount(recorder.latency))
+}
+
+func TestNewPrometheusRegistersOnce(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	_, err := NewPrometheus(registry, "")
+	assert.NoError(t, err)
+
+	_, err = NewPrometheus(registry, "")
+	assert.Error(t, err)
+}
@@ -12,10 +12,10 @@ type (
 		CustomerID                    string  `json:"customer_id"`
 		Currency                      string  `json:"currency"`
 		Reference                     string  `json:"reference"`
-		BVN                           *string `json:"bvn,omitempty"`
+		BVN                           *string `json:"bvn,omitempty" sensitive:"bvn"`
 		PhoneNumber                   *string `json:"phone_number,omitempty"`
 		DocumentType                  *string `json:"document_type,omitempty"`
-		Number                        *string `json:"document_number,omitempty"`
+		Number                        *string `json:"document_number,omitempty" sensitive:"document"`
 		IssuedCountryCode             *string `json:"issued_country_code,omitempty"`
 		IssuedBy                      *string `json:"issued_by,omitempty"`
 		IssuedDate                    *string `json:"issued_date,omitempty"`
@@ -25,11 +25,11 @@ type (
 		City                          *string `json:"city,omitempty"`
 		Street                        *string `json:"street,omitempty"`
 		State                         *string `json:"state,omitempty"`
-		DateOfBirth                   *string `json:"date_of_birth,omitempty"`
+		DateOfBirth                   *string `json:"date_of_birth,omitempty" sensitive:"pii"`
 		AgreementID                   *string `json:"agreement_id,omitempty"`
-		DocumentFrontPage             *string `json:"document_front_page,omitempty"`
-		DocumentBackPage              *string `json:"document_back_page,omitempty"`
-		ProofOfAddressDoc             *string `json:"proof_of_address_doc,omitempty"`
+		DocumentFrontPage             *string `json:"document_front_page,omitempty" sensitive:"document"`
+		DocumentBackPage              *string `json:"document_back_page,omitempty" sensitive:"document"`
+		ProofOfAddressDoc             *string `json:"proof_of_address_doc,omitempty" sensitive:"document"`
 		ActingAsIntermediary          *string `json:"acting_as_intermediary,omitempty"`    // true or false
 		EmploymentStatus              *string `json:"employment_status,omitempty"`         // employed, homemaker, retired, self_employed, student, unemployed
 		ExpectedMonthlyPayments       *string `json:"expected_monthly_payments,omitempty"` // "0_4999", "5000_9999", "10000_49999", "50000_plus"
@@ -37,7 +37,7 @@ type (
 		SourceOfFunds                 *string `json:"source_of_funds,omitempty"`           // "business_transactions", "charitable_donations", "investment_purposes", "payments_to_friends_or_family_abroad", "personal_or_living_expenses", "protect_wealth", "purchase_goods_and_services", "receive_payment_for_freelancing", "other"
 		MostRecentOccupation          *string `json:"most_recent_occupation"`
 		DocumentDescription           *string `json:"document_description"`
-		AdditionalDocument            *string `json:"additional_document"` // passport/nin/other(with good enough description) for NGA
+		AdditionalDocument            *string `json:"additional_document" sensitive:"document"` // passport/nin/other(with good enough description) for NGA
 		AdditionalDocumentPurpose     *string `json:"additional_document_purpose"`
 		AdditionalDocumentDescription *string `json:"additional_document_description"` // required if additional_document_purpose is "other"
 	}
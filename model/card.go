@@ -9,19 +9,19 @@ type (
 		CardType   string `json:"card_type"`
 		ID         struct {
 			Type     string `json:"type"`
-			Value    string `json:"value"`
+			Value    string `json:"value" sensitive:"document"`
 			Country  string `json:"country"`
-			ImageURL string `json:"image_url"`
+			ImageURL string `json:"image_url" sensitive:"document"`
 		} `json:"id"`
 		Reference     string `json:"reference"`
 		PreferredName string `json:"preferred_name"`
 		Address       string `json:"address"`
 		City          string `json:"city"`
 		Country       string `json:"country"`
-		IDNumber      string `json:"id_number"`
+		IDNumber      string `json:"id_number" sensitive:"document"`
 		StateRegion   string `json:"state_region"`
 		PostalCode    string `json:"postal_code"`
-		BirthDate     string `json:"birth_date"`
+		BirthDate     string `json:"birth_date" sensitive:"pii"`
 		Phone         string `json:"phone"`
 	}
 
@@ -93,12 +93,12 @@ type (
 
 	// VaultedCardDetails secure vaulted card details
 	VaultedCardDetails struct {
-		FullPAN      string `json:"full_pan"`      // full card number
-		CVV          string `json:"cvv"`           // sensitive
-		ExpiryDate   string `json:"expiry_date"`   // e.g., "09"
-		NameOnCard   string `json:"name_on_card"`  // optional
-		Issuer       string `json:"issuer"`        // optional, duplicate allowed
-		EphemeralKey string `json:"ephemeral_key"` //optional
+		FullPAN      string `json:"full_pan" sensitive:"pan"`         // full card number
+		CVV          string `json:"cvv" sensitive:"cvv"`              // sensitive
+		ExpiryDate   string `json:"expiry_date"`                      // e.g., "09"
+		NameOnCard   string `json:"name_on_card"`                     // optional
+		Issuer       string `json:"issuer"`                           // optional, duplicate allowed
+		EphemeralKey string `json:"ephemeral_key" sensitive:"secret"` //optional
 	}
 
 	// CustomerPaymentSessionRequest schema for customer payment session request
@@ -120,8 +120,8 @@ type (
 		Reference     string `json:"reference"`
 		CustomerID    string `json:"customer_id"`
 		SessionID     string `json:"session_id"`
-		SessionSecret string `json:"session_secret"`
-		SessionToken  string `json:"session_token"`
+		SessionSecret string `json:"session_secret" sensitive:"secret"`
+		SessionToken  string `json:"session_token" sensitive:"secret"`
 	}
 
 	// CustomerPaymentTokenRequest schema for validating a customer payment token and charging the customer (googlepay/applepay)
@@ -134,8 +134,8 @@ type (
 		Reference  string  `json:"reference" validate:"required"`
 		Remarks    *string `json:"remarks"`
 
-		AppleToken  *ApplepayTokenData  `json:"apple_token" validate:"required_if=Channel applepay"`
-		GoogleToken *GooglepayTokenData `json:"google_token" validate:"required_if=Channel googlepay"`
+		AppleToken  *ApplepayTokenData  `json:"apple_token" validate:"required_if=Channel applepay" sensitive:"token"`
+		GoogleToken *GooglepayTokenData `json:"google_token" validate:"required_if=Channel googlepay" sensitive:"token"`
 	}
 
 	// GooglepayTokenData holds Google Pay token data
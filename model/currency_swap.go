@@ -34,4 +34,12 @@ type (
 		Items []CurrencySwap `json:"items"`
 		Page  PageInfo       `json:"page"`
 	}
+
+	// SwapFilter narrows down the currency swaps listed, empty fields are ignored
+	SwapFilter struct {
+		Status       string `query:"status"`
+		FromCurrency string `query:"from_currency"`
+		ToCurrency   string `query:"to_currency"`
+		Dates        DateInterval
+	}
 )
@@ -59,6 +59,15 @@ type (
 		Page  PageInfo   `json:"page"`
 	}
 
+	// CustomerFilter narrows down the customers listed, empty fields are ignored
+	CustomerFilter struct {
+		// Search matches the name, email or mobile number of the customers
+		Search    string `query:"search"`
+		Email     string `query:"email"`
+		Reference string `query:"reference"`
+		Dates     DateInterval
+	}
+
 	// CustomerBalance schema for customer balance
 	CustomerBalance struct {
 		YieldOfferingID uuid.UUID `json:"yield_offering_id"`
@@ -0,0 +1,94 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// DateRangeLayout is the format of the range bounds sent to the API: RFC 3339 timestamps in UTC, the format of the
+// timestamps the API returns, with the fraction of a second when there is one so that no instant is rounded away
+const DateRangeLayout = time.RFC3339Nano
+
+// ErrInvalidDateRange is returned when a date range ends before it starts
+var ErrInvalidDateRange = fmt.Errorf("%w: invalid date range", ErrValidation)
+
+type (
+	// DateInterval is a date range that can be sent as the from and to query parameters of the list APIs
+	DateInterval interface {
+		// Bounds returns the from and to parameters, empty when the range is open on that side
+		Bounds() (from, to string, err error)
+	}
+
+	// DateRange is a range of instants. The bounds keep their time zone, they are converted to UTC when sent.
+	// A zero bound leaves the range open on that side
+	DateRange struct {
+		From time.Time
+		To   time.Time
+		// ExcludeTo leaves To out of the range, e.g. to cover January with From on 1 January and To on 1 February
+		ExcludeTo bool
+	}
+)
+
+// NewDateRange returns the inclusive range between from and to, failing when to is before from
+func NewDateRange(from, to time.Time) (DateRange, error) {
+	r := DateRange{From: from, To: to}
+	return r, r.Validate()
+}
+
+// LastDays returns the range from the start of the day days-1 days before now, in the time zone of now, up to now.
+// LastDays(now, 1) is today so far
+func LastDays(now time.Time, days int) DateRange {
+	if days < 1 {
+		days = 1
+	}
+	year, month, day := now.Date()
+	return DateRange{
+		From: time.Date(year, month, day-days+1, 0, 0, 0, 0, now.Location()),
+		To:   now,
+	}
+}
+
+// MonthToDate returns the range from the start of the month of now, in the time zone of now, up to now
+func MonthToDate(now time.Time) DateRange {
+	year, month, _ := now.Date()
+	return DateRange{
+		From: time.Date(year, month, 1, 0, 0, 0, 0, now.Location()),
+		To:   now,
+	}
+}
+
+// Validate checks that the range does not end before it starts and is not empty
+func (r DateRange) Validate() error {
+	if r.From.IsZero() || r.To.IsZero() {
+		return nil
+	}
+	if r.To.Before(r.From) || (r.ExcludeTo && r.To.Equal(r.From)) {
+		return fmt.Errorf("%w: %s is before %s", ErrInvalidDateRange, r.To.Format(DateRangeLayout), r.From.Format(DateRangeLayout))
+	}
+	return nil
+}
+
+// Bounds implements DateInterval. The API includes both bounds, so an excluded To is sent as the nanosecond before it,
+// the last instant a time.Time can hold before To
+func (r DateRange) Bounds() (from, to string, err error) {
+	if err = r.Validate(); err != nil {
+		return "", "", err
+	}
+
+	if !r.From.IsZero() {
+		from = r.From.UTC().Format(DateRangeLayout)
+	}
+	if !r.To.IsZero() {
+		end := r.To.UTC()
+		if r.ExcludeTo {
+			end = end.Add(-time.Nanosecond)
+		}
+		to = end.Format(DateRangeLayout)
+	}
+	return from, to, nil
+}
+
+// Bounds implements DateInterval, sending the bounds as they are
+func (d DateBetween) Bounds() (from, to string, err error) {
+	return d.From, d.To, nil
+}
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
+	"strings"
 
 	"github.com/rs/zerolog/log"
 )
@@ -11,6 +13,19 @@ import (
 var (
 	// ErrNetworkError when something goes wrong with the API call
 	ErrNetworkError = errors.New("network error")
+	// ErrNotRetryable marks the transport errors the SDK must not retry, since sending the request again cannot succeed
+	ErrNotRetryable = errors.New("not retryable")
+
+	// ErrNotFound when the requested resource does not exist
+	ErrNotFound = errors.New("resource not found")
+	// ErrDuplicateReference when a reference has already been used for a previous request
+	ErrDuplicateReference = errors.New("duplicate reference")
+	// ErrInsufficientBalance when the balance is not enough to complete the request
+	ErrInsufficientBalance = errors.New("insufficient balance")
+	// ErrUnauthorized when the credentials are missing, invalid or not allowed to perform the request
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrValidation when the request payload or parameters failed validation
+	ErrValidation = errors.New("validation failed")
 )
 
 // ParseError parses error message to a more specific format
@@ -33,8 +48,139 @@ type (
 		Details string `json:"details"`
 		Message string `json:"message"`
 	}
+
+	// APIError is the error returned by the SDK whenever the API responds with a failure
+	APIError struct {
+		// StatusCode is the HTTP status code of the response
+		StatusCode int
+		// ID is the error identifier sent by the API, if any
+		ID string
+		// Details is the human-readable error detail sent by the API
+		Details string
+		// Message is the error message sent by the API
+		Message string
+		// Body is the raw response body
+		Body []byte
+		// RequestID is the request ID echoed by the API, or the one sent when the API did not echo it
+		RequestID string
+	}
+
+	// NetworkError is the error returned by the SDK when no response was received from the API
+	NetworkError struct {
+		// RequestID is the ID of the request that failed
+		RequestID string
+		// Err is the transport error
+		Err error
+	}
+
+	// RequestError is the error returned by the SDK when a call fails on the client side, e.g. while loading the
+	// credentials, waiting for the rate limit or decoding the response
+	RequestError struct {
+		// RequestID is the ID of the request that failed
+		RequestID string
+		// Err is the underlying error
+		Err error
+	}
 )
 
+// NewAPIError builds an APIError from the status code, the decoded error data and the raw body of a response
+func NewAPIError(statusCode int, data *ErrorData, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: statusCode,
+		Body:       body,
+	}
+	if data != nil {
+		apiErr.ID = data.ID
+		apiErr.Details = data.Details
+		apiErr.Message = data.Message
+	}
+	return apiErr
+}
+
+// Error implements the error interface
+func (e *APIError) Error() string {
+	switch {
+	case e.Details != "":
+		return e.Details
+	case e.Message != "":
+		return e.Message
+	case e.StatusCode != 0:
+		return fmt.Sprintf("%d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	default:
+		return "unknown api error"
+	}
+}
+
+// Is reports whether the APIError matches one of the sentinel errors, so that callers can use errors.Is
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound || e.mentions("not found", "not_found")
+	case ErrDuplicateReference:
+		return e.StatusCode == http.StatusConflict || e.mentions("duplicate", "already exist")
+	case ErrInsufficientBalance:
+		return e.mentions("insufficient")
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
+	case ErrValidation:
+		return e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusUnprocessableEntity
+	}
+	return false
+}
+
+// Temporary reports whether the request that produced the error may succeed if sent again
+func (e *APIError) Temporary() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= http.StatusInternalServerError
+}
+
+// Error implements the error interface
+func (e *NetworkError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrNetworkError, e.Err)
+}
+
+// Unwrap allows errors.Is(err, ErrNetworkError) as well as matching the transport error, e.g. context.DeadlineExceeded
+func (e *NetworkError) Unwrap() []error {
+	return []error{ErrNetworkError, e.Err}
+}
+
+// Error implements the error interface
+func (e *RequestError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap allows matching the underlying error, e.g. errors.Is(err, ErrValidation)
+func (e *RequestError) Unwrap() error {
+	return e.Err
+}
+
+// RequestIDOf returns the request ID carried by an error returned by the SDK, or an empty string
+func RequestIDOf(err error) string {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.RequestID
+	}
+	var networkErr *NetworkError
+	if errors.As(err, &networkErr) {
+		return networkErr.RequestID
+	}
+	var requestErr *RequestError
+	if errors.As(err, &requestErr) {
+		return requestErr.RequestID
+	}
+	return ""
+}
+
+// mentions checks the error ID, details and message for any of the given keywords
+func (e *APIError) mentions(keywords ...string) bool {
+	text := strings.ToLower(strings.Join([]string{e.ID, e.Details, e.Message}, " "))
+	for _, keyword := range keywords {
+		if strings.Contains(text, keyword) {
+			return true
+		}
+	}
+	return false
+}
+
 // GetErrorDetails to unmarshal the err response gotten from api-service
 func GetErrorDetails(errMsg string) (ErrorResponse, error) {
 	var result ErrorResponse
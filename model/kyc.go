@@ -22,19 +22,19 @@ type KYCData struct {
 	Name                           string      `json:"name"`
 	Sex                            string      `json:"sex"`
 	MaritalStatus                  string      `json:"marital_status"`
-	DateOfBirth                    string      `json:"date_of_birth"`
+	DateOfBirth                    string      `json:"date_of_birth" sensitive:"pii"`
 	Email                          string      `json:"email"`
 	PhoneNumber                    string      `json:"phone_number"`
 	Country                        string      `json:"country"`
 	ContactType                    string      `json:"contact_type"`
 	Status                         string      `json:"status"`
-	Identity                       string      `json:"identity"`
+	Identity                       string      `json:"identity" sensitive:"document"`
 	IdentityType                   string      `json:"identity_type"`
 	IdentityConfirmed              bool        `json:"identity_confirmed"`
 	IdentityVerificationStatus     string      `json:"identity_verification_status"`
 	IdentityDocumentVerified       bool        `json:"identity_document_verified"`
 	ProofOfAddressDocumentVerified bool        `json:"proof_of_address_document_verified"`
-	TaxIDNumber                    string      `json:"tax_id_number"`
+	TaxIDNumber                    string      `json:"tax_id_number" sensitive:"document"`
 	TaxCountry                     string      `json:"tax_country"`
 	TaxState                       string      `json:"tax_state"`
 	TaxIDVerified                  bool        `json:"tax_id_verified"`
@@ -60,7 +60,7 @@ type Document struct {
 	Label            string      `json:"label"`
 	IsIdentity       bool        `json:"isIdentity"`
 	IsProofOfAddress bool        `json:"isProofOfAddress"`
-	ProviderPayload  interface{} `json:"providerPayload"`
+	ProviderPayload  interface{} `json:"providerPayload" sensitive:"document"`
 	CreatedAt        string      `json:"createdAt"`
 	UpdatedAt        interface{} `json:"updatedAt"`
 	VerifiedAt       interface{} `json:"verifiedAt"`
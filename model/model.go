@@ -1,6 +1,8 @@
 // Package model defines object and payload models
 package model
 
+import "encoding/json"
+
 type (
 	// Key is a middleware key sting value
 	Key string
@@ -8,7 +10,13 @@ type (
 
 const (
 	// BaseURL is the definition of ovalfi base url
-	BaseURL = "https://sandbox-api.ovalfi-app.com/api/"
+	BaseURL = SandboxBaseURL
+
+	// SandboxBaseURL is the base url of the ovalfi sandbox environment
+	SandboxBaseURL = "https://sandbox-api.ovalfi-app.com/api/"
+
+	// ProductionBaseURL is the base url of the ovalfi live environment
+	ProductionBaseURL = "https://api.ovalfi-app.com/api/"
 
 	// APISecret sample sandbox environment signature
 	APISecret = "YbAO71rFXyWp0WJq-_yH7AFV6cZ7P71V53Y=" //"_Wjz3hGNJ8h1FwjJhNHnHXJJmT9Dkg=="  // "XC-WlyMxbC7MdS-mlzZ0G1tBBUXu"
@@ -34,10 +42,29 @@ const (
 	// LogErrorCode log error_code
 	LogErrorCode = "error_code"
 
+	// LogRequestID log request_id
+	LogRequestID = "request_id"
+
+	// LogBusinessID log business_id
+	LogBusinessID = "business_id"
+
 	// RequestIDContextKey contact that holds the RequestID context key for
 	RequestIDContextKey Key = "api_RequestIDContextKey"
 	// RequestIDHeaderKey a constant for the request id header key
 	RequestIDHeaderKey string = "X-REQUEST-ID"
+
+	// RetryPolicyContextKey is the context key holding the retry policy override for a call
+	RetryPolicyContextKey Key = "api_RetryPolicyContextKey"
+	// IdempotencyKeyContextKey is the context key holding the idempotency key override for a call
+	IdempotencyKeyContextKey Key = "api_IdempotencyKeyContextKey"
+	// IdempotencyKeyHeaderKey a constant for the idempotency key header key
+	IdempotencyKeyHeaderKey string = "Idempotency-Key"
+	// ResponseInfoContextKey is the context key holding the collector of the response metadata of a call
+	ResponseInfoContextKey Key = "api_ResponseInfoContextKey"
+	// BusinessIDContextKey is the context key holding the business a ClientPool hands the client of
+	BusinessIDContextKey Key = "api_BusinessIDContextKey"
+	// CallerContextKey is the context key holding the context a call was made with, before the SDK bounded it with timeouts
+	CallerContextKey Key = "api_CallerContextKey"
 )
 
 type (
@@ -143,10 +170,10 @@ type (
 
 	// GenericResponse response wrapper
 	GenericResponse struct {
-		Code    int         `json:"status"`
-		Data    interface{} `json:"data"`
-		Message *string     `json:"message"`
-		Error   *ErrorData  `json:"error"`
+		Code    int             `json:"status"`
+		Data    json.RawMessage `json:"data"`
+		Message *string         `json:"message"`
+		Error   *ErrorData      `json:"error"`
 	}
 
 	// WalletDetails schema for wallet details
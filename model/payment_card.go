@@ -12,21 +12,21 @@ type (
 	InitiateCardRequest struct {
 		CustomerID  string  `json:"customer_id"  validate:"required"`
 		Reference   string  `json:"reference"  validate:"required"`
-		DateOfBirth string  `json:"date_of_birth" validate:"required"` // format: DD-MMM-YYYY (17-JAN-1985)
-		SSN         *string `json:"ssn,omitempty"`                     // Social Security Number of the user (format: Last four ####)
-		Phone       string  `json:"phone" validate:"required"`         // Phone number of the user (format: +15557771234)
-		Address     string  `json:"address" validate:"required"`       // Address line of the user (PO Boxes are not allowed)
-		City        string  `json:"city" validate:"required"`          // City of the user
-		State       string  `json:"state" validate:"required,len=2"`   // State of the user
-		PostalCode  string  `json:"postal_code" validate:"required"`   // Postal code of the user
-		IPAddress   string  `json:"ip_address" validate:"required"`    // IP address of the user
+		DateOfBirth string  `json:"date_of_birth" validate:"required" sensitive:"pii"` // format: DD-MMM-YYYY (17-JAN-1985)
+		SSN         *string `json:"ssn,omitempty" sensitive:"ssn"`                     // Social Security Number of the user (format: Last four ####)
+		Phone       string  `json:"phone" validate:"required"`                         // Phone number of the user (format: +15557771234)
+		Address     string  `json:"address" validate:"required"`                       // Address line of the user (PO Boxes are not allowed)
+		City        string  `json:"city" validate:"required"`                          // City of the user
+		State       string  `json:"state" validate:"required,len=2"`                   // State of the user
+		PostalCode  string  `json:"postal_code" validate:"required"`                   // Postal code of the user
+		IPAddress   string  `json:"ip_address" validate:"required"`                    // IP address of the user
 		RedirectURI string  `json:"redirect_uri" validate:"required"`
 	}
 
 	// CompleteCardRequest to complete card request payload
 	CompleteCardRequest struct {
 		CustomerID  string `json:"customer_id"  validate:"required"`
-		AuthCode    string `json:"auth_code"  validate:"required"`
+		AuthCode    string `json:"auth_code"  validate:"required" sensitive:"secret"`
 		RedirectURI string `json:"redirect_uri" validate:"required"`
 	}
 
@@ -62,6 +62,13 @@ type (
 		Page  PageInfo       `json:"page"`
 	}
 
+	// PaymentCardFilter narrows down the payment cards of a customer listed, empty fields are ignored
+	PaymentCardFilter struct {
+		Status string `query:"status"`
+		Search string `query:"search"`
+		Dates  DateInterval
+	}
+
 	// DebitCustomerPaymentCardRequest for request payload
 	DebitCustomerPaymentCardRequest struct {
 		CustomerID    string  `json:"customer_id"`
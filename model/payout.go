@@ -79,6 +79,13 @@ type (
 		Page  PageInfo        `json:"page"`
 	}
 
+	// PayoutFilter narrows down the payouts listed, empty fields are ignored
+	PayoutFilter struct {
+		Status string `query:"status"`
+		Search string `query:"search"`
+		Dates  DateInterval
+	}
+
 	// CancelPayoutRequest schema for cancel payout request
 	CancelPayoutRequest struct {
 		BulkPayoutID string `json:"payout_id"`
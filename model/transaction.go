@@ -25,4 +25,15 @@ type (
 		} `json:"items"`
 		Page PageInfo `json:"page"`
 	}
+
+	// TransactionFilter narrows down the transactions listed, empty fields are ignored
+	TransactionFilter struct {
+		CustomerID      string   `query:"customer_id"`
+		YieldOfferingID string   `query:"yield_offering_id"`
+		Status          string   `query:"status"`
+		Reference       string   `query:"reference"`
+		BatchDate       string   `query:"batch_date"`
+		Amount          *float64 `query:"amount"`
+		Dates           DateInterval
+	}
 )
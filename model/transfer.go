@@ -112,6 +112,14 @@ type (
 		Page  PageInfo           `json:"page"`
 	}
 
+	// TerminalTransferFilter narrows down the terminal transfers listed, empty fields are ignored
+	TerminalTransferFilter struct {
+		Status              string `query:"status"`
+		SourceCurrency      string `query:"source_currency"`
+		DestinationCurrency string `query:"destination_currency"`
+		Dates               DateInterval
+	}
+
 	// Settlement schema for settlement
 	Settlement struct {
 		ID                uuid.UUID       `json:"id"`
@@ -0,0 +1,35 @@
+package model
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+)
+
+type (
+	// Upload is a file sent as a multipart form field. It is streamed to the API, so the reader is consumed only once
+	Upload struct {
+		// Reader streams the content of the file
+		Reader io.Reader
+		// Filename is the name of the file sent to the API
+		Filename string
+		// ContentType is the MIME type of the file, detected from the filename or the content when empty
+		ContentType string
+		// Size is the size of the file in bytes, 0 when unknown
+		Size int64
+	}
+)
+
+// NewFileUpload returns the upload of an open file, sent under its base name
+func NewFileUpload(file *os.File) (Upload, error) {
+	info, err := file.Stat()
+	if err != nil {
+		return Upload{}, err
+	}
+
+	return Upload{
+		Reader:   file,
+		Filename: filepath.Base(file.Name()),
+		Size:     info.Size(),
+	}, nil
+}
@@ -0,0 +1,375 @@
+// Package replay records the HTTP interactions of the SDK into cassette files and replays them offline,
+// so that tests of code built on the SDK run without network
+package replay
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/ovalfi/go-sdk/helpers"
+	"github.com/ovalfi/go-sdk/model"
+)
+
+// Mode tells whether a Transport sends requests to the API or answers them from its cassette
+type Mode int
+
+const (
+	// ModeAuto replays the cassette when the file exists and records a new one otherwise
+	ModeAuto Mode = iota
+	// ModeReplay answers every request from the cassette and never touches the network
+	ModeReplay
+	// ModeRecord sends every request to the API and records the interactions, replacing the cassette on Save
+	ModeRecord
+)
+
+// multipartBody replaces the body of multipart requests, whose random boundary makes them impossible to match
+const multipartBody = "[multipart body]"
+
+// ErrNoInteraction is returned when replaying a request the cassette has no interaction for. The SDK does not retry it
+var ErrNoInteraction = errors.New("no recorded interaction matches the request")
+
+// scrubbedHeaders are the request and response headers whose value is never written to a cassette
+var scrubbedHeaders = []string{"Authorization", "Signature", "Cookie", "Set-Cookie"}
+
+type (
+	// Cassette is the content of a cassette file
+	Cassette struct {
+		Interactions []*Interaction `json:"interactions"`
+	}
+
+	// Interaction is a request and the response the API sent to it
+	Interaction struct {
+		Request  RecordedRequest  `json:"request"`
+		Response RecordedResponse `json:"response"`
+
+		replayed bool
+	}
+
+	// RecordedRequest is a scrubbed request
+	RecordedRequest struct {
+		Method string      `json:"method"`
+		Path   string      `json:"path"`
+		Query  string      `json:"query,omitempty"`
+		Header http.Header `json:"header,omitempty"`
+		Body   string      `json:"body,omitempty"`
+	}
+
+	// RecordedResponse is a scrubbed response
+	RecordedResponse struct {
+		StatusCode int         `json:"status_code"`
+		Header     http.Header `json:"header,omitempty"`
+		Body       string      `json:"body,omitempty"`
+	}
+
+	// Transport is an http.RoundTripper recording interactions into a cassette or replaying them from it
+	Transport struct {
+		path      string
+		mode      Mode
+		transport http.RoundTripper
+		keys      []string
+		scrubbers []func(*Interaction)
+
+		mu       sync.Mutex
+		cassette Cassette
+	}
+
+	// Option configures a Transport
+	Option func(*Transport)
+)
+
+// WithTransport sets the transport requests are sent with when recording. Defaults to http.DefaultTransport
+func WithTransport(transport http.RoundTripper) Option {
+	return func(t *Transport) {
+		t.transport = transport
+	}
+}
+
+// WithScrubbedKeys masks the given JSON keys, query parameters and headers in the recorded interactions, on top of the
+// card data, secrets and identity documents the SDK never logs, e.g. WithScrubbedKeys("email", "phone")
+func WithScrubbedKeys(keys ...string) Option {
+	return func(t *Transport) {
+		t.keys = append(t.keys, keys...)
+	}
+}
+
+// WithScrubber runs a function over every interaction before it is recorded
+func WithScrubber(scrubber func(*Interaction)) Option {
+	return func(t *Transport) {
+		t.scrubbers = append(t.scrubbers, scrubber)
+	}
+}
+
+// New returns a Transport using the cassette at path, which is loaded unless recording
+//
+//	transport, err := replay.New("testdata/transfers.json", replay.ModeAuto)
+//	defer transport.Save()
+//	client, err := api.NewClient(api.WithHTTPClient(&http.Client{Transport: transport}), ...)
+func New(path string, mode Mode, opts ...Option) (*Transport, error) {
+	t := &Transport{
+		path:      path,
+		mode:      mode,
+		transport: http.DefaultTransport,
+	}
+	for _, opt := range opts {
+		opt(t)
+	}
+
+	if t.mode == ModeAuto {
+		t.mode = ModeRecord
+		if _, err := os.Stat(path); err == nil {
+			t.mode = ModeReplay
+		}
+	}
+
+	if t.mode == ModeReplay {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err = json.Unmarshal(data, &t.cassette); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+	return t, nil
+}
+
+// Mode returns the mode the transport runs in, ModeAuto being resolved when the transport is created
+func (t *Transport) Mode() Mode {
+	return t.mode
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	request, body, err := t.recordRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.mode == ModeReplay {
+		return t.replay(req, request)
+	}
+
+	if body != nil {
+		req = req.Clone(req.Context())
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	res, err := t.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	responseBody, err := io.ReadAll(res.Body)
+	_ = res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	interaction := &Interaction{
+		Request: request,
+		Response: RecordedResponse{
+			StatusCode: res.StatusCode,
+			Header:     t.scrubHeader(res.Header),
+			Body:       t.scrubBody(res.Header.Get("Content-Type"), responseBody),
+		},
+	}
+	for _, scrubber := range t.scrubbers {
+		scrubber(interaction)
+	}
+
+	t.mu.Lock()
+	t.cassette.Interactions = append(t.cassette.Interactions, interaction)
+	t.mu.Unlock()
+
+	return res, nil
+}
+
+// Save writes the recorded interactions to the cassette file. It does nothing when replaying
+func (t *Transport) Save() error {
+	if t.mode != ModeRecord {
+		return nil
+	}
+
+	t.mu.Lock()
+	data, err := json.MarshalIndent(t.cassette, "", "  ")
+	t.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(t.path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(t.path, append(data, '\n'), 0o600)
+}
+
+// recordRequest returns the scrubbed form of a request, and its body so that it can be sent after being read
+func (t *Transport) recordRequest(req *http.Request) (RecordedRequest, []byte, error) {
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return RecordedRequest{}, nil, err
+		}
+	}
+
+	return RecordedRequest{
+		Method: req.Method,
+		Path:   helpers.RedactPath(req.URL.Path),
+		Query:  t.scrubQuery(req.URL.Query()),
+		Header: t.scrubHeader(req.Header),
+		Body:   t.scrubBody(req.Header.Get("Content-Type"), body),
+	}, body, nil
+}
+
+// replay answers a request with the first matching interaction not replayed yet, or the last matching one
+func (t *Transport) replay(req *http.Request, request RecordedRequest) (*http.Response, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var match *Interaction
+	for _, interaction := range t.cassette.Interactions {
+		if !interaction.Request.matches(request) {
+			continue
+		}
+		match = interaction
+		if !interaction.replayed {
+			break
+		}
+	}
+	if match == nil {
+		return nil, &missError{request: request}
+	}
+	match.replayed = true
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", match.Response.StatusCode, http.StatusText(match.Response.StatusCode)),
+		StatusCode:    match.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        match.Response.Header.Clone(),
+		Body:          io.NopCloser(strings.NewReader(match.Response.Body)),
+		ContentLength: int64(len(match.Response.Body)),
+		Request:       req,
+	}, nil
+}
+
+// missError is returned for a request the cassette has no interaction for
+type missError struct {
+	request RecordedRequest
+}
+
+// Error implements the error interface
+func (e *missError) Error() string {
+	return fmt.Sprintf("%s %s?%s: %s", e.request.Method, e.request.Path, e.request.Query, ErrNoInteraction)
+}
+
+// Unwrap matches ErrNoInteraction, and model.ErrNotRetryable so that the SDK fails at once instead of retrying
+func (e *missError) Unwrap() []error {
+	return []error{ErrNoInteraction, model.ErrNotRetryable}
+}
+
+// matches compares the method, path, query and body of two requests
+func (r RecordedRequest) matches(other RecordedRequest) bool {
+	return r.Method == other.Method &&
+		r.Path == other.Path &&
+		r.Query == other.Query &&
+		r.Body == other.Body
+}
+
+// scrubBody masks the sensitive values of JSON bodies, which are written with sorted keys so that they compare equal
+func (t *Transport) scrubBody(contentType string, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	if strings.HasPrefix(mediaType, "multipart/") {
+		return multipartBody
+	}
+
+	var payload interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&payload); err != nil || decoder.More() {
+		return string(body)
+	}
+
+	scrubbed, err := json.Marshal(helpers.Redact(scrubKeys(payload, t.keys)))
+	if err != nil {
+		return string(body)
+	}
+	return string(scrubbed)
+}
+
+// scrubHeader returns a copy of the headers with the credentials, cookies and the keys given with WithScrubbedKeys masked
+func (t *Transport) scrubHeader(header http.Header) http.Header {
+	scrubbed := header.Clone()
+	for key, values := range scrubbed {
+		if !contains(scrubbedHeaders, key) && !contains(t.keys, key) {
+			continue
+		}
+		for i, value := range values {
+			if value != "" {
+				values[i] = helpers.RedactedValue
+			}
+		}
+	}
+	return scrubbed
+}
+
+// scrubQuery masks the sensitive query parameters the same way as the keys of the bodies
+func (t *Transport) scrubQuery(query url.Values) string {
+	for key, values := range query {
+		for i, value := range values {
+			scrubbed := scrubKeys(helpers.Redact(map[string]interface{}{key: value}), t.keys)
+			values[i] = fmt.Sprint(scrubbed.(map[string]interface{})[key])
+		}
+	}
+	return query.Encode()
+}
+
+// scrubKeys masks the values of the given keys anywhere in a decoded JSON payload
+func scrubKeys(payload interface{}, keys []string) interface{} {
+	if len(keys) == 0 {
+		return payload
+	}
+
+	switch value := payload.(type) {
+	case map[string]interface{}:
+		for key, item := range value {
+			if item != nil && item != "" && contains(keys, key) {
+				value[key] = helpers.RedactedValue
+				continue
+			}
+			value[key] = scrubKeys(item, keys)
+		}
+	case []interface{}:
+		for i, item := range value {
+			value[i] = scrubKeys(item, keys)
+		}
+	}
+	return payload
+}
+
+func contains(keys []string, key string) bool {
+	for _, k := range keys {
+		if strings.EqualFold(k, key) {
+			return true
+		}
+	}
+	return false
+}
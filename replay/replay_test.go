@@ -0,0 +1,181 @@
+package replay
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ovalfi/go-sdk/api"
+	"github.com/ovalfi/go-sdk/model"
+)
+
+func newClient(t *testing.T, transport *Transport, baseURL string) api.RemoteCalls {
+	t.Helper()
+	client, err := api.NewClient(
+		api.WithCredentials("secret", "token"),
+		api.WithBaseURL(baseURL+"/"),
+		api.WithHTTPClient(&http.Client{Transport: transport}),
+		api.WithRetries(api.NoRetryPolicy),
+	)
+	require.NoError(t, err)
+	return client
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	calls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v1/customer-transfers/quote":
+			_, _ = w.Write([]byte(`{"data":{"rate":1500,"amount":` + r.URL.Query().Get("amount") + `}}`))
+		default:
+			_, _ = w.Write([]byte(`{"data":{"id":"bill-1","reference":"ref-1","cvv":"123"}}`))
+		}
+	}))
+	defer ts.Close()
+
+	path := filepath.Join(t.TempDir(), "testdata", "cassette.json")
+	ctx := context.Background()
+
+	recorder, err := New(path, ModeAuto)
+	require.NoError(t, err)
+	require.Equal(t, ModeRecord, recorder.Mode())
+
+	client := newClient(t, recorder, ts.URL)
+	rate, err := client.GetExchangeRates(ctx, 100, "USD", "NGN")
+	require.NoError(t, err)
+	_, err = client.GetExchangeRates(ctx, 200, "USD", "NGN")
+	require.NoError(t, err)
+	bill, err := client.PayBill(ctx, model.PayBillRequest{Code: "mtn-100", CustomerID: "customer-1", Amount: 100})
+	require.NoError(t, err)
+	require.NoError(t, recorder.Save())
+	require.Equal(t, 3, calls)
+
+	cassette, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(cassette), "token")
+	assert.NotContains(t, string(cassette), `"123"`)
+	assert.Contains(t, string(cassette), "[REDACTED]")
+
+	player, err := New(path, ModeAuto)
+	require.NoError(t, err)
+	require.Equal(t, ModeReplay, player.Mode())
+	client = newClient(t, player, ts.URL)
+
+	replayedRate, err := client.GetExchangeRates(ctx, 100, "USD", "NGN")
+	require.NoError(t, err)
+	assert.Equal(t, rate, replayedRate)
+
+	replayedBill, err := client.PayBill(ctx, model.PayBillRequest{Code: "mtn-100", CustomerID: "customer-1", Amount: 100})
+	require.NoError(t, err)
+	assert.Equal(t, bill.ID, replayedBill.ID)
+	assert.Equal(t, 3, calls)
+
+	// a different query or body has no recorded interaction
+	_, err = client.GetExchangeRates(ctx, 300, "USD", "NGN")
+	assert.True(t, errors.Is(err, ErrNoInteraction))
+	_, err = client.PayBill(ctx, model.PayBillRequest{Code: "mtn-200", CustomerID: "customer-1", Amount: 100})
+	assert.True(t, errors.Is(err, ErrNoInteraction))
+	assert.Equal(t, 3, calls)
+}
+
+func TestRecordScrubsQueryPathAndHeaders(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "session=cookie-secret")
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	defer ts.Close()
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	ctx := context.Background()
+
+	recorder, err := New(path, ModeRecord, WithScrubbedKeys("customer_id"))
+	require.NoError(t, err)
+	client := newClient(t, recorder, ts.URL)
+	_, err = client.GetCustomerCardSecureDetails(ctx, "card-1", "customer-1", "nonce-secret")
+	require.NoError(t, err)
+	_, err = client.VerifyCustomerKYC(ctx, "customer-1", "12345678901", "nin")
+	require.NoError(t, err)
+	require.NoError(t, recorder.Save())
+
+	cassette, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(cassette), "nonce-secret")
+	assert.NotContains(t, string(cassette), "cookie-secret")
+	assert.NotContains(t, string(cassette), "customer_id=customer-1")
+	assert.NotContains(t, string(cassette), "12345678901")
+
+	player, err := New(path, ModeReplay, WithScrubbedKeys("customer_id"))
+	require.NoError(t, err)
+	client = newClient(t, player, ts.URL)
+	_, err = client.GetCustomerCardSecureDetails(ctx, "card-1", "customer-1", "nonce-secret")
+	assert.NoError(t, err)
+	_, err = client.VerifyCustomerKYC(ctx, "customer-1", "12345678901", "nin")
+	assert.NoError(t, err)
+}
+
+func TestReplayMissIsNotRetried(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"interactions":[]}`), 0o600))
+	player, err := New(path, ModeReplay)
+	require.NoError(t, err)
+
+	client, err := api.NewClient(
+		api.WithCredentials("secret", "token"),
+		api.WithBaseURL("http://sandbox/"),
+		api.WithHTTPClient(&http.Client{Transport: player}),
+		api.WithRetries(api.RetryPolicy{MaxAttempts: 5, InitialBackoff: time.Millisecond}),
+	)
+	require.NoError(t, err)
+
+	var info api.ResponseInfo
+	_, err = client.GetBanks(api.WithResponseInfo(context.Background(), &info))
+	assert.ErrorIs(t, err, ErrNoInteraction)
+	assert.Equal(t, 1, info.Attempts)
+}
+
+func TestReplayMissingCassette(t *testing.T) {
+	_, err := New(filepath.Join(t.TempDir(), "missing.json"), ModeReplay)
+	assert.True(t, errors.Is(err, os.ErrNotExist))
+}
+
+func TestReplayInOrder(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"interactions":[
+		{"request":{"method":"GET","path":"/status"},"response":{"status_code":202,"body":"pending"}},
+		{"request":{"method":"GET","path":"/status"},"response":{"status_code":200,"body":"done"}}
+	]}`), 0o600))
+
+	player, err := New(path, ModeReplay)
+	require.NoError(t, err)
+
+	for _, expected := range []int{http.StatusAccepted, http.StatusOK, http.StatusOK} {
+		req := httptest.NewRequest(http.MethodGet, "http://sandbox/status", nil)
+		res, err := player.RoundTrip(req)
+		require.NoError(t, err)
+		_ = res.Body.Close()
+		assert.Equal(t, expected, res.StatusCode)
+	}
+}
+
+func TestScrub(t *testing.T) {
+	transport := &Transport{keys: []string{"email"}}
+
+	assert.Equal(t,
+		`{"customer":{"email":"[REDACTED]","name":"Ada"},"full_pan":"************4242"}`,
+		transport.scrubBody("application/json", []byte(`{"full_pan":"4242424242424242","customer":{"name":"Ada","email":"ada@example.com"}}`)),
+	)
+	assert.Equal(t, `{"amount":100000000000000001}`, transport.scrubBody("application/json", []byte(`{"amount": 100000000000000001}`)))
+	assert.Equal(t, "plain", transport.scrubBody("text/plain", []byte("plain")))
+	assert.Equal(t, multipartBody, transport.scrubBody("multipart/form-data; boundary=abc", []byte("--abc")))
+}